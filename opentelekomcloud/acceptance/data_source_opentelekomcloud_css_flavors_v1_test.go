@@ -0,0 +1,33 @@
+package acceptance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+const dataSourceCssFlavorsV1Name = "data.opentelekomcloud_css_flavors_v1.flavor"
+
+func TestAccCssFlavorsV1DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCssFlavorsV1DataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceCssFlavorsV1Name, "flavors.0.id"),
+					resource.TestCheckResourceAttr(dataSourceCssFlavorsV1Name, "flavors.0.name", "css.medium.8"),
+					resource.TestCheckResourceAttr(dataSourceCssFlavorsV1Name, "flavors.0.type", "ess"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCssFlavorsV1DataSource_basic = `
+data "opentelekomcloud_css_flavors_v1" "flavor" {
+  name      = "css.medium.8"
+  disk_size = 40
+}
+`
@@ -0,0 +1,35 @@
+package acceptance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+const dataSourceDcsFlavorsV2Name = "data.opentelekomcloud_dcs_flavors_v2.flavors"
+
+func TestAccDcsFlavorsV2DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDcsFlavorsV2DataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceDcsFlavorsV2Name, "flavors.#"),
+					resource.TestCheckResourceAttr(dataSourceDcsFlavorsV2Name, "flavors.0.cache_mode", "ha"),
+					resource.TestCheckResourceAttr(dataSourceDcsFlavorsV2Name, "flavors.0.capacity", "1"),
+					resource.TestCheckResourceAttr(dataSourceDcsFlavorsV2Name, "flavors.0.engine", "Redis"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDcsFlavorsV2DataSource_basic = `
+data "opentelekomcloud_dcs_flavors_v2" "flavors" {
+  engine_version = "5.0"
+  cache_mode     = "ha"
+  capacity       = 1
+}
+`
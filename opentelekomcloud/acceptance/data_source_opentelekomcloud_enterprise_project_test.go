@@ -0,0 +1,47 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccEnterpriseProjectDataSource_basic(t *testing.T) {
+	name := fmt.Sprintf("tf_acc_eps_%s", acctest.RandString(5))
+	dataSourceName := "data.opentelekomcloud_enterprise_project.project"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnterpriseProjectDataSource_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						dataSourceName, "id", "opentelekomcloud_enterprise_project.project", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", name),
+					resource.TestCheckResourceAttr(dataSourceName, "description", "data source test"),
+					resource.TestCheckResourceAttr(dataSourceName, "enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEnterpriseProjectDataSource_basic(name string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_enterprise_project" "project" {
+  name        = "%s"
+  description = "data source test"
+}
+
+data "opentelekomcloud_enterprise_project" "project" {
+  name = opentelekomcloud_enterprise_project.project.name
+}
+`, name)
+}
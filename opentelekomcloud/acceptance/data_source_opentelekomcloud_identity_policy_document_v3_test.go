@@ -0,0 +1,73 @@
+package acceptance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccIdentityPolicyDocumentV3DataSource_basic(t *testing.T) {
+	dataSourceName := "data.opentelekomcloud_identity_policy_document_v3.policy"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityPolicyDocumentV3DataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "json", testAccIdentityPolicyDocumentV3Expected),
+				),
+			},
+		},
+	})
+}
+
+const testAccIdentityPolicyDocumentV3DataSource_basic = `
+data "opentelekomcloud_identity_policy_document_v3" "policy" {
+  statement {
+    action   = ["obs:object:PutObject", "obs:object:GetObject"]
+    resource = ["obs:*:*:object:my-bucket/*"]
+
+    condition {
+      operator = "StringEquals"
+      key      = "g:UserName"
+      values   = ["user_b", "user_a"]
+    }
+  }
+
+  statement {
+    effect = "Deny"
+    action = ["obs:bucket:DeleteBucket"]
+  }
+}
+`
+
+const testAccIdentityPolicyDocumentV3Expected = `{
+  "Version": "1.1",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "obs:object:GetObject",
+        "obs:object:PutObject"
+      ],
+      "Resource": [
+        "obs:*:*:object:my-bucket/*"
+      ],
+      "Condition": {
+        "StringEquals": {
+          "g:UserName": [
+            "user_a",
+            "user_b"
+          ]
+        }
+      }
+    },
+    {
+      "Effect": "Deny",
+      "Action": [
+        "obs:bucket:DeleteBucket"
+      ]
+    }
+  ]
+}`
@@ -0,0 +1,48 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccIdentityV3UsersDataSource_basic(t *testing.T) {
+	userName := fmt.Sprintf("tf_test_%s", acctest.RandString(5))
+	dataSourceName := "data.opentelekomcloud_identity_users_v3.users"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityV3UsersDataSource_basic(userName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "users.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "users.0.name", userName),
+					resource.TestCheckResourceAttr(dataSourceName, "users.0.access_mode", "programmatic"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ids.0", "opentelekomcloud_identity_user_v3.user", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIdentityV3UsersDataSource_basic(userName string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_identity_user_v3" "user" {
+  name        = "%s"
+  password    = "password123@!"
+  access_mode = "programmatic"
+}
+
+data "opentelekomcloud_identity_users_v3" "users" {
+  name_regex  = "^${opentelekomcloud_identity_user_v3.user.name}$"
+  access_mode = "programmatic"
+}
+`, userName)
+}
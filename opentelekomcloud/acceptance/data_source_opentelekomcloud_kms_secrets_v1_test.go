@@ -0,0 +1,69 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccKmsSecretsV1_basic(t *testing.T) {
+	keyAlias := fmt.Sprintf("kms_%s", acctest.RandString(5))
+	dataSourceName := "data.opentelekomcloud_kms_secrets_v1.secrets"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKmsV1KeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKmsSecretsV1_basic(keyAlias),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("opentelekomcloud_kms_ciphertext_v1.cipher", "ciphertext_blob"),
+					resource.TestCheckResourceAttr(dataSourceName, "plaintext.password", "P@ssw0rd1!9851"),
+					resource.TestCheckResourceAttr(dataSourceName, "plaintext.token", "some-token"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKmsSecretsV1_basic(keyAlias string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_kms_key_v1" "key_1" {
+  key_alias    = "%s"
+  pending_days = "7"
+}
+
+resource "opentelekomcloud_kms_ciphertext_v1" "cipher" {
+  key_id    = opentelekomcloud_kms_key_v1.key_1.id
+  plaintext = "P@ssw0rd1!9851"
+
+  encryption_context = {
+    service = "rds"
+  }
+}
+
+resource "opentelekomcloud_kms_ciphertext_v1" "token" {
+  key_id    = opentelekomcloud_kms_key_v1.key_1.id
+  plaintext = "some-token"
+}
+
+data "opentelekomcloud_kms_secrets_v1" "secrets" {
+  secret {
+    name    = "password"
+    payload = opentelekomcloud_kms_ciphertext_v1.cipher.ciphertext_blob
+
+    encryption_context = {
+      service = "rds"
+    }
+  }
+
+  secret {
+    name    = "token"
+    payload = opentelekomcloud_kms_ciphertext_v1.token.ciphertext_blob
+  }
+}
+`, keyAlias)
+}
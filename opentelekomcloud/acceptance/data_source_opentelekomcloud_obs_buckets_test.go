@@ -0,0 +1,55 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDataSourceObsBuckets_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	dataSourceName := "data.opentelekomcloud_obs_buckets.buckets"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceObsBucketsConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "buckets.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "buckets.0.bucket", testAccObsBucketName(rInt)),
+					resource.TestCheckResourceAttr(dataSourceName, "buckets.0.storage_class", "WARM"),
+					resource.TestCheckResourceAttr(dataSourceName, "buckets.0.region", OS_REGION_NAME),
+					resource.TestCheckResourceAttr(dataSourceName, "buckets.0.tags.foo", "bar"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceObsBucketsConfig(randInt int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_obs_bucket" "bucket" {
+  bucket        = "tf-test-bucket-%[1]d"
+  storage_class = "WARM"
+
+  tags = {
+    foo = "bar"
+  }
+}
+
+data "opentelekomcloud_obs_buckets" "buckets" {
+  name_regex    = "^tf-test-bucket-%[1]d$"
+  storage_class = "WARM"
+
+  tags = {
+    foo = "bar"
+  }
+
+  depends_on = [opentelekomcloud_obs_bucket.bucket]
+}
+`, randInt)
+}
@@ -0,0 +1,44 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccRdsBackupsV3DataSource_basic(t *testing.T) {
+	postfix := acctest.RandString(3)
+	dataSourceName := "data.opentelekomcloud_rds_backups_v3.backups"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRdsBackupV3Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRdsBackupsV3DataSource_basic(postfix),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRdsFlavorV3DataSourceID(dataSourceName),
+					resource.TestCheckResourceAttr(dataSourceName, "backups.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						dataSourceName, "backups.0.id",
+						"opentelekomcloud_rds_backup_v3.backup", "id",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccRdsBackupsV3DataSource_basic(postfix string) string {
+	return fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_rds_backups_v3" "backups" {
+  instance_id = opentelekomcloud_rds_backup_v3.backup.instance_id
+  backup_type = "manual"
+}
+`, testAccRdsBackupV3_basic(postfix))
+}
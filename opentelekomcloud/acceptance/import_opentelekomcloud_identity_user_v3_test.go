@@ -30,6 +30,8 @@ func TestAccIdentityV3User_importBasic(t *testing.T) {
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
 					"password",
+					"pwd_reset",
+					"send_welcome_email",
 				},
 			},
 		},
@@ -29,6 +29,69 @@ func TestAccCssClusterV1_basic(t *testing.T) {
 	})
 }
 
+func TestAccCssClusterV1_update(t *testing.T) {
+	postfix := acctest.RandString(10)
+	resourceName := "opentelekomcloud_css_cluster_v1.cluster"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCssClusterV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCssClusterV1_update(postfix, "css.medium.8", 40, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCssClusterV1Exists(),
+					resource.TestCheckResourceAttr(resourceName, "node_config.0.flavor", "css.medium.8"),
+					resource.TestCheckResourceAttr(resourceName, "node_config.0.volume.0.size", "40"),
+					resource.TestCheckResourceAttr(resourceName, "enable_authority", "false"),
+				),
+			},
+			{
+				Config: testAccCssClusterV1_update(postfix, "css.large.8", 80, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCssClusterV1Exists(),
+					resource.TestCheckResourceAttr(resourceName, "node_config.0.flavor", "css.large.8"),
+					resource.TestCheckResourceAttr(resourceName, "node_config.0.volume.0.size", "80"),
+					resource.TestCheckResourceAttr(resourceName, "enable_authority", "true"),
+					resource.TestCheckResourceAttr(resourceName, "enable_https", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCssClusterV1_update(val, flavor string, size int, secure bool) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_networking_secgroup_v2" "secgroup" {
+  name        = "terraform_test_security_group%[1]s"
+  description = "terraform security group acceptance test"
+}
+
+resource "opentelekomcloud_css_cluster_v1" "cluster" {
+  expect_node_num  = 1
+  name             = "terraform_test_cluster%[1]s"
+  enable_https     = %[6]t
+  enable_authority = %[6]t
+  admin_pass       = "QwertyUI!123"
+
+  node_config {
+    flavor = "%[2]s"
+    network_info {
+      security_group_id = opentelekomcloud_networking_secgroup_v2.secgroup.id
+      network_id        = "%[4]s"
+      vpc_id            = "%[5]s"
+    }
+    volume {
+      volume_type = "COMMON"
+      size        = %[3]d
+    }
+    availability_zone = "%[7]s"
+  }
+}
+`, val, flavor, size, OS_NETWORK_ID, OS_VPC_ID, secure, OS_AVAILABILITY_ZONE)
+}
+
 func testAccCssClusterV1_basic(val string) string {
 	return fmt.Sprintf(`
 resource "opentelekomcloud_networking_secgroup_v2" "secgroup" {
@@ -0,0 +1,143 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/css"
+)
+
+const resourceCssSnapshotV1Name = "opentelekomcloud_css_snapshot_v1.snapshot"
+
+func TestAccCssSnapshotV1_basic(t *testing.T) {
+	postfix := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCssSnapshotV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCssSnapshotV1_basic(postfix),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCssSnapshotV1Exists(resourceCssSnapshotV1Name),
+					resource.TestCheckResourceAttr(resourceCssSnapshotV1Name, "status", "COMPLETED"),
+					resource.TestCheckResourceAttr("opentelekomcloud_css_cluster_v1.cluster", "backup_strategy.#", "1"),
+					resource.TestCheckResourceAttr("opentelekomcloud_css_cluster_v1.cluster", "backup_strategy.0.keep_days", "3"),
+				),
+			},
+			{
+				ResourceName:      resourceCssSnapshotV1Name,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccCssSnapshotV1ImportStateIdFunc(resourceCssSnapshotV1Name),
+			},
+		},
+	})
+}
+
+func testAccCssSnapshotV1ImportStateIdFunc(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", name)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["cluster_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccCheckCssSnapshotV1Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*cfg.Config)
+	client, err := config.CssV1Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud CSSv1 client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_css_snapshot_v1" {
+			continue
+		}
+		snapshot, _ := css.GetCssSnapshot(client, rs.Primary.Attributes["cluster_id"], rs.Primary.ID)
+		if snapshot != nil {
+			return fmt.Errorf("CSS snapshot still exists")
+		}
+	}
+	return nil
+}
+
+func testAccCheckCssSnapshotV1Exists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		config := testAccProvider.Meta().(*cfg.Config)
+		client, err := config.CssV1Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating OpenTelekomCloud CSSv1 client: %s", err)
+		}
+
+		snapshot, err := css.GetCssSnapshot(client, rs.Primary.Attributes["cluster_id"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if snapshot == nil {
+			return fmt.Errorf("CSS snapshot not found")
+		}
+		return nil
+	}
+}
+
+func testAccCssSnapshotV1_basic(val string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_networking_secgroup_v2" "secgroup" {
+  name = "terraform_test_security_group%[1]s"
+}
+
+resource "opentelekomcloud_obs_bucket" "bucket" {
+  bucket = "tf-css-snapshot-%[1]s"
+  acl    = "private"
+}
+
+resource "opentelekomcloud_css_cluster_v1" "cluster" {
+  expect_node_num = 1
+  name            = "terraform_test_cluster%[1]s"
+
+  node_config {
+    flavor = "css.medium.8"
+    network_info {
+      security_group_id = opentelekomcloud_networking_secgroup_v2.secgroup.id
+      network_id        = "%[2]s"
+      vpc_id            = "%[3]s"
+    }
+    volume {
+      volume_type = "COMMON"
+      size        = 40
+    }
+    availability_zone = "%[4]s"
+  }
+
+  backup_strategy {
+    bucket    = opentelekomcloud_obs_bucket.bucket.bucket
+    agency    = "css_obs_agency"
+    period    = "00:00 GMT+01:00"
+    keep_days = 3
+  }
+}
+
+resource "opentelekomcloud_css_snapshot_v1" "snapshot" {
+  cluster_id  = opentelekomcloud_css_cluster_v1.cluster.id
+  name        = "snapshot-%[1]s"
+  description = "manual snapshot"
+}
+`, val, OS_NETWORK_ID, OS_VPC_ID, OS_AVAILABILITY_ZONE)
+}
@@ -0,0 +1,191 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/dcs"
+)
+
+const resourceDcsInstanceV2Name = "opentelekomcloud_dcs_instance_v2.instance_1"
+
+func TestAccDcsInstancesV2_basic(t *testing.T) {
+	var instanceName = fmt.Sprintf("dcs_instance_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckDcs(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDcsV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDcsV2Instance_basic(instanceName, 4, "192.168.0.0/24"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDcsV2InstanceExists(resourceDcsInstanceV2Name),
+					resource.TestCheckResourceAttr(resourceDcsInstanceV2Name, "name", instanceName),
+					resource.TestCheckResourceAttr(resourceDcsInstanceV2Name, "engine", "Redis"),
+					resource.TestCheckResourceAttr(resourceDcsInstanceV2Name, "capacity", "4"),
+					resource.TestCheckResourceAttr(resourceDcsInstanceV2Name, "cache_mode", "cluster"),
+					resource.TestCheckResourceAttr(resourceDcsInstanceV2Name, "whitelists.#", "1"),
+					resource.TestCheckResourceAttr(resourceDcsInstanceV2Name, "tags.muh", "value-create"),
+				),
+			},
+			{
+				Config: testAccDcsV2Instance_basic(instanceName, 8, "192.168.10.0/24"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDcsV2InstanceExists(resourceDcsInstanceV2Name),
+					resource.TestCheckResourceAttr(resourceDcsInstanceV2Name, "capacity", "8"),
+					resource.TestCheckResourceAttr(resourceDcsInstanceV2Name, "whitelists.#", "1"),
+					resource.TestCheckResourceAttr(resourceDcsInstanceV2Name, "tags.muh", "value-create"),
+				),
+			},
+			{
+				ResourceName:      resourceDcsInstanceV2Name,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"password",
+				},
+			},
+		},
+	})
+}
+
+func TestAccDcsInstancesV2_noPassword(t *testing.T) {
+	var instanceName = fmt.Sprintf("dcs_instance_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckDcs(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDcsV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDcsV2Instance_password(instanceName, `password = "Hungarian_rapsody1"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDcsV2InstanceExists(resourceDcsInstanceV2Name),
+					resource.TestCheckResourceAttr(resourceDcsInstanceV2Name, "no_password_access", "false"),
+				),
+			},
+			{
+				Config: testAccDcsV2Instance_password(instanceName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDcsV2InstanceExists(resourceDcsInstanceV2Name),
+					resource.TestCheckResourceAttr(resourceDcsInstanceV2Name, "no_password_access", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDcsV2InstanceDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*cfg.Config)
+	client, err := config.DcsV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DCSv2 client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_dcs_instance_v2" {
+			continue
+		}
+
+		_, err := dcs.GetInstanceV2(client, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("DCSv2 instance still exists")
+		}
+	}
+	return nil
+}
+
+func testAccCheckDcsV2InstanceExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		config := testAccProvider.Meta().(*cfg.Config)
+		client, err := config.DcsV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating OpenTelekomCloud DCSv2 client: %s", err)
+		}
+
+		v, err := dcs.GetInstanceV2(client, rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error getting DCSv2 instance %s: %s", rs.Primary.ID, err)
+		}
+
+		if v.InstanceID != rs.Primary.ID {
+			return fmt.Errorf("DCSv2 instance not found")
+		}
+		return nil
+	}
+}
+
+func testAccDcsV2Instance_basic(instanceName string, capacity int, cidr string) string {
+	return fmt.Sprintf(`
+data "opentelekomcloud_dcs_flavors_v2" "flavors" {
+  engine_version = "5.0"
+  cache_mode     = "cluster"
+  capacity       = %[1]d
+}
+
+resource "opentelekomcloud_dcs_instance_v2" "instance_1" {
+  name               = "%[2]s"
+  engine_version     = "5.0"
+  password           = "Hungarian_rapsody1"
+  capacity           = %[1]d
+  flavor             = data.opentelekomcloud_dcs_flavors_v2.flavors.flavors[0].name
+  availability_zones = ["%[3]s"]
+  vpc_id             = "%[4]s"
+  subnet_id          = "%[5]s"
+
+  backup_policy {
+    backup_type = "auto"
+    begin_at    = "00:00-01:00"
+    period_type = "weekly"
+    backup_at   = [4]
+    save_days   = 1
+  }
+
+  whitelists {
+    group_name = "test-group"
+    ip_address = ["%[6]s"]
+  }
+
+  tags = {
+    muh = "value-create"
+  }
+}
+`, capacity, instanceName, OS_AVAILABILITY_ZONE, OS_VPC_ID, OS_NETWORK_ID, cidr)
+}
+
+func testAccDcsV2Instance_password(instanceName string, access string) string {
+	return fmt.Sprintf(`
+data "opentelekomcloud_dcs_flavors_v2" "flavors" {
+  engine_version = "5.0"
+  cache_mode     = "ha"
+  capacity       = 1
+}
+
+resource "opentelekomcloud_dcs_instance_v2" "instance_1" {
+  name               = "%s"
+  engine_version     = "5.0"
+  capacity           = 1
+  flavor             = data.opentelekomcloud_dcs_flavors_v2.flavors.flavors[0].name
+  availability_zones = ["%s"]
+  vpc_id             = "%s"
+  subnet_id          = "%s"
+
+  %s
+}
+`, instanceName, OS_AVAILABILITY_ZONE, OS_VPC_ID, OS_NETWORK_ID, access)
+}
@@ -46,6 +46,35 @@ func TestAccDDSV3Instance_minConfig(t *testing.T) {
 	})
 }
 
+func TestAccDDSV3Instance_scaling(t *testing.T) {
+	resourceName := "opentelekomcloud_dds_instance_v3.instance"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDDSV3InstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDDSInstanceV3Config_scaling(20, "08:00-09:00", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDDSV3InstanceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "flavor.0.size", "20"),
+					resource.TestCheckResourceAttr(resourceName, "backup_strategy.0.keep_days", "1"),
+				),
+			},
+			{
+				Config: testAccDDSInstanceV3Config_scaling(30, "10:00-11:00", 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDDSV3InstanceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "flavor.0.size", "30"),
+					resource.TestCheckResourceAttr(resourceName, "backup_strategy.0.start_time", "10:00-11:00"),
+					resource.TestCheckResourceAttr(resourceName, "backup_strategy.0.keep_days", "3"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckDDSV3InstanceDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*cfg.Config)
 	client, err := config.DdsV3Client(OS_REGION_NAME)
@@ -168,3 +197,35 @@ resource "opentelekomcloud_dds_instance_v3" "instance" {
     spec_code = "dds.mongodb.s2.medium.4.repset"
   }
 }`, OS_AVAILABILITY_ZONE, OS_VPC_ID, OS_NETWORK_ID)
+
+func testAccDDSInstanceV3Config_scaling(size int, backupStart string, keepDays int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_networking_secgroup_v2" "sg_acc" {
+  name = "secgroup_acc"
+}
+resource "opentelekomcloud_dds_instance_v3" "instance" {
+  name              = "dds-instance"
+  availability_zone = "%s"
+  datastore {
+    type           = "DDS-Community"
+    version        = "3.4"
+    storage_engine = "wiredTiger"
+  }
+  vpc_id            = "%s"
+  subnet_id         = "%s"
+  security_group_id = opentelekomcloud_networking_secgroup_v2.sg_acc.id
+  password          = "5ecuredPa55w0rd@"
+  mode              = "ReplicaSet"
+  flavor {
+    type      = "replica"
+    num       = 1
+    storage   = "ULTRAHIGH"
+    size      = %d
+    spec_code = "dds.mongodb.s2.medium.4.repset"
+  }
+  backup_strategy {
+    start_time = "%s"
+    keep_days  = %d
+  }
+}`, OS_AVAILABILITY_ZONE, OS_VPC_ID, OS_NETWORK_ID, size, backupStart, keepDays)
+}
@@ -0,0 +1,116 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/dds"
+)
+
+func TestAccDDSV3ReadReplica_basic(t *testing.T) {
+	resourceName := "opentelekomcloud_dds_read_replica_v3.replica"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDDSV3InstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDDSReadReplicaV3Config_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDDSV3ReadReplicaExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "spec_code", "dds.mongodb.s2.medium.4.repset"),
+					resource.TestCheckResourceAttrSet(resourceName, "private_ip"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccDDSV3ReadReplicaImportStateIdFunc(resourceName),
+				ImportStateVerifyIgnore: []string{
+					"delay",
+				},
+			},
+		},
+	})
+}
+
+func testAccDDSV3ReadReplicaImportStateIdFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", n)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["instance_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccCheckDDSV3ReadReplicaExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		config := testAccProvider.Meta().(*cfg.Config)
+		client, err := config.DdsV3Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating OpenTelekomCloud DDSv3 client: %s", err)
+		}
+
+		instance, err := dds.GetDdsInstance(client, rs.Primary.Attributes["instance_id"])
+		if err != nil {
+			return err
+		}
+		if instance == nil {
+			return fmt.Errorf("dds instance not found")
+		}
+		for _, group := range instance.Groups {
+			for _, node := range group.Nodes {
+				if node.Id == rs.Primary.ID {
+					return nil
+				}
+			}
+		}
+		return fmt.Errorf("dds read replica not found")
+	}
+}
+
+var testAccDDSReadReplicaV3Config_basic = fmt.Sprintf(`
+resource "opentelekomcloud_networking_secgroup_v2" "sg_acc" {
+  name = "secgroup_acc"
+}
+resource "opentelekomcloud_dds_instance_v3" "instance" {
+  name              = "dds-instance"
+  availability_zone = "%s"
+  datastore {
+    type           = "DDS-Community"
+    version        = "3.4"
+    storage_engine = "wiredTiger"
+  }
+  vpc_id            = "%s"
+  subnet_id         = "%s"
+  security_group_id = opentelekomcloud_networking_secgroup_v2.sg_acc.id
+  password          = "5ecuredPa55w0rd@"
+  mode              = "ReplicaSet"
+  flavor {
+    type      = "replica"
+    num       = 1
+    size      = 20
+    spec_code = "dds.mongodb.s2.medium.4.repset"
+  }
+}
+
+resource "opentelekomcloud_dds_read_replica_v3" "replica" {
+  instance_id = opentelekomcloud_dds_instance_v3.instance.id
+  spec_code   = "dds.mongodb.s2.medium.4.repset"
+}
+`, OS_AVAILABILITY_ZONE, OS_VPC_ID, OS_NETWORK_ID)
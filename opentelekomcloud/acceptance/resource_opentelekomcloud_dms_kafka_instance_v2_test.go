@@ -0,0 +1,185 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/dms"
+)
+
+const (
+	resourceKafkaInstanceV2Name = "opentelekomcloud_dms_kafka_instance_v2.instance_1"
+	resourceKafkaTopicV2Name    = "opentelekomcloud_dms_kafka_topic_v2.topic_1"
+	resourceKafkaUserV2Name     = "opentelekomcloud_dms_kafka_user_v2.user_1"
+)
+
+func TestAccDmsKafkaInstanceV2_basic(t *testing.T) {
+	var instanceName = fmt.Sprintf("dms_kafka_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckDms(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDmsKafkaInstanceV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDmsKafkaInstanceV2_basic(instanceName, 3, 72, "pub"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDmsKafkaInstanceV2Exists(resourceKafkaInstanceV2Name),
+					resource.TestCheckResourceAttr(resourceKafkaInstanceV2Name, "name", instanceName),
+					resource.TestCheckResourceAttr(resourceKafkaInstanceV2Name, "ssl_enable", "true"),
+					resource.TestCheckResourceAttr(resourceKafkaTopicV2Name, "partition", "3"),
+					resource.TestCheckResourceAttr(resourceKafkaTopicV2Name, "retention_time", "72"),
+					resource.TestCheckResourceAttr(resourceKafkaUserV2Name, "permissions.#", "1"),
+				),
+			},
+			{
+				Config: testAccDmsKafkaInstanceV2_basic(instanceName, 6, 48, "all"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDmsKafkaInstanceV2Exists(resourceKafkaInstanceV2Name),
+					resource.TestCheckResourceAttr(resourceKafkaTopicV2Name, "partition", "6"),
+					resource.TestCheckResourceAttr(resourceKafkaTopicV2Name, "retention_time", "48"),
+					resource.TestCheckResourceAttr(resourceKafkaUserV2Name, "permissions.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceKafkaInstanceV2Name,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"password",
+					"manager_password",
+				},
+			},
+			{
+				ResourceName:      resourceKafkaTopicV2Name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:            resourceKafkaUserV2Name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func testAccCheckDmsKafkaInstanceV2Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*cfg.Config)
+	client, err := config.DmsV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DMSv2 client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_dms_kafka_instance_v2" {
+			continue
+		}
+
+		_, err := dms.GetKafkaInstance(client, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("DMS Kafka instance still exists")
+		}
+	}
+	return nil
+}
+
+func testAccCheckDmsKafkaInstanceV2Exists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		config := testAccProvider.Meta().(*cfg.Config)
+		client, err := config.DmsV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating OpenTelekomCloud DMSv2 client: %s", err)
+		}
+
+		v, err := dms.GetKafkaInstance(client, rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error getting DMS Kafka instance %s: %s", rs.Primary.ID, err)
+		}
+		if v.InstanceID != rs.Primary.ID {
+			return fmt.Errorf("DMS Kafka instance not found")
+		}
+
+		topic, err := dms.GetKafkaTopic(client, rs.Primary.ID, "topic_1")
+		if err != nil {
+			return err
+		}
+		if topic == nil {
+			return fmt.Errorf("DMS Kafka topic not found")
+		}
+		return nil
+	}
+}
+
+func testAccDmsKafkaInstanceV2_base(instanceName string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_networking_secgroup_v2" "secgroup_1" {
+  name = "secgroup_kafka"
+}
+
+data "opentelekomcloud_dms_az_v1" "az_1" {}
+
+data "opentelekomcloud_dms_product_v1" "product_1" {
+  engine        = "kafka"
+  version       = "2.3.0"
+  instance_type = "cluster"
+  partition_num = 300
+  storage       = 600
+  bandwidth     = "100MB"
+}
+
+resource "opentelekomcloud_dms_kafka_instance_v2" "instance_1" {
+  name              = "%s"
+  specification     = data.opentelekomcloud_dms_product_v1.product_1.bandwidth
+  storage_space     = data.opentelekomcloud_dms_product_v1.product_1.storage
+  product_id        = data.opentelekomcloud_dms_product_v1.product_1.id
+  vpc_id            = "%s"
+  subnet_id         = "%s"
+  security_group_id = opentelekomcloud_networking_secgroup_v2.secgroup_1.id
+  available_zones   = [data.opentelekomcloud_dms_az_v1.az_1.id]
+  access_user       = "user"
+  password          = "Dmstest@123"
+  manager_user      = "kafka-user"
+  manager_password  = "Kafkatest@123"
+}
+`, instanceName, OS_VPC_ID, OS_NETWORK_ID)
+}
+
+func testAccDmsKafkaInstanceV2_basic(instanceName string, partition, retention int, policy string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_dms_kafka_topic_v2" "topic_1" {
+  instance_id    = opentelekomcloud_dms_kafka_instance_v2.instance_1.id
+  name           = "topic_1"
+  partition      = %d
+  retention_time = %d
+}
+
+resource "opentelekomcloud_dms_kafka_user_v2" "user_1" {
+  instance_id = opentelekomcloud_dms_kafka_instance_v2.instance_1.id
+  name        = "user_1"
+  password    = "Dmstest@123"
+
+  permissions {
+    topic         = opentelekomcloud_dms_kafka_topic_v2.topic_1.name
+    access_policy = "%s"
+  }
+}
+`, testAccDmsKafkaInstanceV2_base(instanceName), partition, retention, policy)
+}
@@ -0,0 +1,120 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/dms"
+)
+
+func TestAccDmsKafkaTopicV2_basic(t *testing.T) {
+	var topicID string
+	var instanceName = fmt.Sprintf("dms_kafka_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckDms(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDmsKafkaTopicV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDmsKafkaTopicV2_basic(instanceName, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDmsKafkaTopicV2Exists(resourceKafkaTopicV2Name, &topicID),
+					resource.TestCheckResourceAttr(resourceKafkaTopicV2Name, "name", "topic_1"),
+					resource.TestCheckResourceAttr(resourceKafkaTopicV2Name, "partition", "3"),
+				),
+			},
+			{
+				// partitions are added in place
+				Config: testAccDmsKafkaTopicV2_basic(instanceName, 6),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDmsKafkaTopicV2Exists(resourceKafkaTopicV2Name, &topicID),
+					resource.TestCheckResourceAttr(resourceKafkaTopicV2Name, "partition", "6"),
+				),
+			},
+			{
+				// topic is recreated when partitions are reduced
+				Config: testAccDmsKafkaTopicV2_basic(instanceName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceKafkaTopicV2Name, "partition", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceKafkaTopicV2Name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDmsKafkaTopicV2Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*cfg.Config)
+	client, err := config.DmsV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DMSv2 client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_dms_kafka_topic_v2" {
+			continue
+		}
+
+		topic, _ := dms.GetKafkaTopic(client, rs.Primary.Attributes["instance_id"], rs.Primary.Attributes["name"])
+		if topic != nil {
+			return fmt.Errorf("DMS Kafka topic still exists")
+		}
+	}
+	return nil
+}
+
+// testAccCheckDmsKafkaTopicV2Exists checks the topic exists and its ID isn't changed since the previous check
+func testAccCheckDmsKafkaTopicV2Exists(n string, topicID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+		if *topicID != "" && *topicID != rs.Primary.ID {
+			return fmt.Errorf("DMS Kafka topic ID changed from %s to %s", *topicID, rs.Primary.ID)
+		}
+
+		config := testAccProvider.Meta().(*cfg.Config)
+		client, err := config.DmsV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating OpenTelekomCloud DMSv2 client: %s", err)
+		}
+
+		topic, err := dms.GetKafkaTopic(client, rs.Primary.Attributes["instance_id"], rs.Primary.Attributes["name"])
+		if err != nil {
+			return err
+		}
+		if topic == nil {
+			return fmt.Errorf("DMS Kafka topic not found")
+		}
+
+		*topicID = rs.Primary.ID
+		return nil
+	}
+}
+
+func testAccDmsKafkaTopicV2_basic(instanceName string, partition int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_dms_kafka_topic_v2" "topic_1" {
+  instance_id = opentelekomcloud_dms_kafka_instance_v2.instance_1.id
+  name        = "topic_1"
+  partition   = %d
+}
+`, testAccDmsKafkaInstanceV2_base(instanceName), partition)
+}
@@ -0,0 +1,174 @@
+package acceptance
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/dms"
+)
+
+func TestAccDmsKafkaUserV2_basic(t *testing.T) {
+	var instanceName = fmt.Sprintf("dms_kafka_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckDms(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDmsKafkaUserV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDmsKafkaUserV2_basic(instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDmsKafkaUserV2Exists(resourceKafkaUserV2Name),
+					resource.TestCheckResourceAttr(resourceKafkaUserV2Name, "name", "user_1"),
+					resource.TestCheckResourceAttr(resourceKafkaUserV2Name, "permissions.#", "1"),
+					testAccCheckDmsKafkaUserV2Permission(resourceKafkaUserV2Name, "topic_1", "pub"),
+				),
+			},
+			{
+				Config: testAccDmsKafkaUserV2_update(instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDmsKafkaUserV2Exists(resourceKafkaUserV2Name),
+					resource.TestCheckResourceAttr(resourceKafkaUserV2Name, "permissions.#", "2"),
+					testAccCheckDmsKafkaUserV2Permission(resourceKafkaUserV2Name, "topic_1", "sub"),
+					testAccCheckDmsKafkaUserV2Permission(resourceKafkaUserV2Name, "topic_2", "all"),
+				),
+			},
+			{
+				ResourceName:            resourceKafkaUserV2Name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func testAccCheckDmsKafkaUserV2Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*cfg.Config)
+	client, err := config.DmsV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DMSv2 client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_dms_kafka_user_v2" {
+			continue
+		}
+
+		user, _ := dms.GetKafkaUser(client, rs.Primary.Attributes["instance_id"], rs.Primary.Attributes["name"])
+		if user != nil {
+			return fmt.Errorf("DMS Kafka user still exists")
+		}
+	}
+	return nil
+}
+
+func testAccCheckDmsKafkaUserV2Exists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		config := testAccProvider.Meta().(*cfg.Config)
+		client, err := config.DmsV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating OpenTelekomCloud DMSv2 client: %s", err)
+		}
+
+		user, err := dms.GetKafkaUser(client, rs.Primary.Attributes["instance_id"], rs.Primary.Attributes["name"])
+		if err != nil {
+			return err
+		}
+		if user == nil {
+			return fmt.Errorf("DMS Kafka user not found")
+		}
+		return nil
+	}
+}
+
+// testAccCheckDmsKafkaUserV2Permission checks the access policy of the user for the topic read from the topic ACL
+func testAccCheckDmsKafkaUserV2Permission(n, topic, policy string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		for key, value := range rs.Primary.Attributes {
+			if !strings.HasPrefix(key, "permissions.") || !strings.HasSuffix(key, ".topic") || value != topic {
+				continue
+			}
+			policyKey := strings.TrimSuffix(key, ".topic") + ".access_policy"
+			if actual := rs.Primary.Attributes[policyKey]; actual != policy {
+				return fmt.Errorf("expected %s access policy for topic %s, got %s", policy, topic, actual)
+			}
+			return nil
+		}
+		return fmt.Errorf("permission for topic %s not found", topic)
+	}
+}
+
+func testAccDmsKafkaUserV2_basic(instanceName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_dms_kafka_topic_v2" "topic_1" {
+  instance_id = opentelekomcloud_dms_kafka_instance_v2.instance_1.id
+  name        = "topic_1"
+}
+
+resource "opentelekomcloud_dms_kafka_user_v2" "user_1" {
+  instance_id = opentelekomcloud_dms_kafka_instance_v2.instance_1.id
+  name        = "user_1"
+  password    = "Dmstest@123"
+
+  permissions {
+    topic         = opentelekomcloud_dms_kafka_topic_v2.topic_1.name
+    access_policy = "pub"
+  }
+}
+`, testAccDmsKafkaInstanceV2_base(instanceName))
+}
+
+func testAccDmsKafkaUserV2_update(instanceName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_dms_kafka_topic_v2" "topic_1" {
+  instance_id = opentelekomcloud_dms_kafka_instance_v2.instance_1.id
+  name        = "topic_1"
+}
+
+resource "opentelekomcloud_dms_kafka_topic_v2" "topic_2" {
+  instance_id = opentelekomcloud_dms_kafka_instance_v2.instance_1.id
+  name        = "topic_2"
+}
+
+resource "opentelekomcloud_dms_kafka_user_v2" "user_1" {
+  instance_id = opentelekomcloud_dms_kafka_instance_v2.instance_1.id
+  name        = "user_1"
+  password    = "Dmstest@123"
+
+  permissions {
+    topic         = opentelekomcloud_dms_kafka_topic_v2.topic_1.name
+    access_policy = "sub"
+  }
+
+  permissions {
+    topic         = opentelekomcloud_dms_kafka_topic_v2.topic_2.name
+    access_policy = "all"
+  }
+}
+`, testAccDmsKafkaInstanceV2_base(instanceName))
+}
@@ -0,0 +1,88 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func TestAccEnterpriseProject_basic(t *testing.T) {
+	name := fmt.Sprintf("tf_acc_eps_%s", acctest.RandString(5))
+	resourceName := "opentelekomcloud_enterprise_project.project"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEnterpriseProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnterpriseProject_basic(name, "created", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "description", "created"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "status", "1"),
+				),
+			},
+			{
+				Config: testAccEnterpriseProject_basic(name+"_updated", "updated", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name+"_updated"),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "status", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testAccCheckEnterpriseProjectDestroy checks that enterprise projects are disabled as they can't be deleted
+func testAccCheckEnterpriseProjectDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*cfg.Config)
+	client, err := config.EpsV1Client()
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud EPS client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_enterprise_project" {
+			continue
+		}
+		var res struct {
+			Project struct {
+				Status int `json:"status"`
+			} `json:"enterprise_project"`
+		}
+		if _, err := client.Get(client.ServiceURL("enterprise-projects", rs.Primary.ID), &res, nil); err != nil {
+			return err
+		}
+		if res.Project.Status != 2 {
+			return fmt.Errorf("enterprise project %s is still enabled", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccEnterpriseProject_basic(name, description string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_enterprise_project" "project" {
+  name        = "%s"
+  description = "%s"
+  enabled     = %t
+}
+`, name, description, enabled)
+}
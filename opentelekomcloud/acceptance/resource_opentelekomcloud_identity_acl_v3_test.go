@@ -0,0 +1,73 @@
+package acceptance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccIdentityACLV3_basic(t *testing.T) {
+	resourceName := "opentelekomcloud_identity_acl_v3.acl"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityACLV3_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", "api"),
+					resource.TestCheckResourceAttr(resourceName, "ip_cidrs.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ip_ranges.#", "1"),
+				),
+			},
+			{
+				Config: testAccIdentityACLV3_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ip_cidrs.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "ip_ranges.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccIdentityACLV3_basic = `
+resource "opentelekomcloud_identity_acl_v3" "acl" {
+  type = "api"
+
+  ip_cidrs {
+    cidr        = "0.0.0.0/0"
+    description = "all"
+  }
+
+  ip_ranges {
+    range       = "0.0.0.0-255.255.255.255"
+    description = "all"
+  }
+}
+`
+
+const testAccIdentityACLV3_update = `
+resource "opentelekomcloud_identity_acl_v3" "acl" {
+  type = "api"
+
+  ip_cidrs {
+    cidr        = "0.0.0.0/0"
+    description = "all"
+  }
+
+  ip_cidrs {
+    cidr        = "192.168.0.0/16"
+    description = "office"
+  }
+}
+`
@@ -0,0 +1,94 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/identity/v3/agency"
+)
+
+func TestAccIdentityV3AgencyRoleAssignment_basic(t *testing.T) {
+	var a agency.Agency
+	projectResourceName := "opentelekomcloud_identity_agency_role_assignment_v3.project"
+	domainResourceName := "opentelekomcloud_identity_agency_role_assignment_v3.domain"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccIdentityV3AgencyPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIdentityV3AgencyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityV3AgencyRoleAssignment_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3AgencyExists("opentelekomcloud_identity_agency_v3.agency", &a),
+					testAccCheckIdentityV3AgencyRoleAssigned(projectResourceName),
+					testAccCheckIdentityV3AgencyRoleAssigned(domainResourceName),
+					resource.TestCheckResourceAttrPair(projectResourceName, "project_id", "data.opentelekomcloud_identity_project_v3.project", "id"),
+				),
+			},
+			{
+				ResourceName:      projectResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      domainResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIdentityV3AgencyRoleAssigned(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+		if rs.Primary.Attributes["role_id"] == "" {
+			return fmt.Errorf("role_id is not set")
+		}
+		return nil
+	}
+}
+
+var testAccIdentityV3AgencyRoleAssignment_basic = fmt.Sprintf(`
+data "opentelekomcloud_identity_project_v3" "project" {
+  name = "%s"
+}
+
+data "opentelekomcloud_identity_role_v3" "kms_adm" {
+  name = "kms_adm"
+}
+
+data "opentelekomcloud_identity_role_v3" "readonly" {
+  name = "readonly"
+}
+
+resource "opentelekomcloud_identity_agency_v3" "agency" {
+  name                   = "test_role_assignment"
+  delegated_service_name = "op_svc_evs"
+}
+
+resource "opentelekomcloud_identity_agency_role_assignment_v3" "project" {
+  agency_id  = opentelekomcloud_identity_agency_v3.agency.id
+  project_id = data.opentelekomcloud_identity_project_v3.project.id
+  role_id    = data.opentelekomcloud_identity_role_v3.kms_adm.id
+}
+
+resource "opentelekomcloud_identity_agency_role_assignment_v3" "domain" {
+  agency_id = opentelekomcloud_identity_agency_v3.agency.id
+  domain_id = data.opentelekomcloud_identity_project_v3.project.domain_id
+  role_id   = data.opentelekomcloud_identity_role_v3.readonly.id
+}
+`, OS_TENANT_NAME)
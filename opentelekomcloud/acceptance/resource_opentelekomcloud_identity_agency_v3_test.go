@@ -39,6 +39,37 @@ func TestAccIdentityV3Agency_basic(t *testing.T) {
 	})
 }
 
+func TestAccIdentityV3Agency_service(t *testing.T) {
+	var a agency.Agency
+	resourceName := "opentelekomcloud_identity_agency_v3.agency_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIdentityV3AgencyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityV3Agency_service("FOREVER"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3AgencyExists(resourceName, &a),
+					resource.TestCheckResourceAttr(resourceName, "delegated_service_name", "op_svc_evs"),
+					resource.TestCheckResourceAttr(resourceName, "duration", "FOREVER"),
+				),
+			},
+			{
+				Config: testAccIdentityV3Agency_service("ONEDAY"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3AgencyExists(resourceName, &a),
+					resource.TestCheckResourceAttr(resourceName, "duration", "ONEDAY"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIdentityV3AgencyDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*cfg.Config)
 	identityClient, err := config.IdentityV3Client(OS_REGION_NAME)
@@ -106,3 +137,13 @@ var testAccIdentityV3Agency_basic = fmt.Sprintf(`
     	}
 		]
     }`, OS_TENANT_NAME)
+
+func testAccIdentityV3Agency_service(duration string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_identity_agency_v3" "agency_1" {
+  name                   = "test_service"
+  delegated_service_name = "op_svc_evs"
+  duration               = "%s"
+}
+`, duration)
+}
@@ -0,0 +1,60 @@
+package acceptance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccIdentityLoginPolicyV3_basic(t *testing.T) {
+	resourceName := "opentelekomcloud_identity_login_policy_v3.policy"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityLoginPolicyV3_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "login_failed_times", "3"),
+					resource.TestCheckResourceAttr(resourceName, "lockout_duration", "30"),
+					resource.TestCheckResourceAttr(resourceName, "show_recent_login_info", "true"),
+				),
+			},
+			{
+				Config: testAccIdentityLoginPolicyV3_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "login_failed_times", "5"),
+					resource.TestCheckResourceAttr(resourceName, "session_timeout", "120"),
+					resource.TestCheckResourceAttr(resourceName, "custom_info_for_login", "Authorized use only"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccIdentityLoginPolicyV3_basic = `
+resource "opentelekomcloud_identity_login_policy_v3" "policy" {
+  login_failed_times     = 3
+  lockout_duration       = 30
+  show_recent_login_info = true
+}
+`
+
+const testAccIdentityLoginPolicyV3_update = `
+resource "opentelekomcloud_identity_login_policy_v3" "policy" {
+  login_failed_times     = 5
+  lockout_duration       = 30
+  session_timeout        = 120
+  custom_info_for_login  = "Authorized use only"
+  show_recent_login_info = true
+}
+`
@@ -0,0 +1,59 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccIdentityLoginProtectionV3_basic(t *testing.T) {
+	userName := fmt.Sprintf("tf_acc_user_%s", acctest.RandString(5))
+	resourceName := "opentelekomcloud_identity_login_protection_v3.protection"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIdentityV3UserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityLoginProtectionV3_basic(userName, "email"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "user_id", "opentelekomcloud_identity_user_v3.user", "id"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "verification_method", "email"),
+				),
+			},
+			{
+				Config: testAccIdentityLoginProtectionV3_basic(userName, "vmfa"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "verification_method", "vmfa"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccIdentityLoginProtectionV3_basic(userName, method string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_identity_user_v3" "user" {
+  name     = "%s"
+  password = "password123@!"
+  email    = "test@acme.org"
+}
+
+resource "opentelekomcloud_identity_login_protection_v3" "protection" {
+  user_id             = opentelekomcloud_identity_user_v3.user.id
+  verification_method = "%s"
+}
+`, userName, method)
+}
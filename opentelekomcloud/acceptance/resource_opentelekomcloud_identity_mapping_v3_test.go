@@ -0,0 +1,74 @@
+package acceptance
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccIdentityMappingV3_basic(t *testing.T) {
+	mappingID := fmt.Sprintf("tf_acc_mapping_%s", acctest.RandString(5))
+	resourceName := "opentelekomcloud_identity_mapping_v3.mapping"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIdentityFederationV3Destroy("opentelekomcloud_identity_mapping_v3", "mappings"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityMappingV3_basic(mappingID, "admin"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityFederationV3Exists(resourceName, "mappings"),
+					resource.TestCheckResourceAttr(resourceName, "mapping_id", mappingID),
+				),
+			},
+			{
+				Config: testAccIdentityMappingV3_basic(mappingID, "readonly"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityFederationV3Exists(resourceName, "mappings"),
+					resource.TestMatchResourceAttr(resourceName, "rules", regexp.MustCompile(`"name":"readonly"`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccIdentityMappingV3_basic(mappingID, group string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_identity_mapping_v3" "mapping" {
+  mapping_id = "%s"
+  rules = jsonencode([
+    {
+      local = [
+        {
+          user = {
+            name = "{0}"
+          }
+        },
+        {
+          group = {
+            name = "%s"
+          }
+        }
+      ]
+      remote = [
+        {
+          type = "UserName"
+        }
+      ]
+    }
+  ])
+}
+`, mappingID, group)
+}
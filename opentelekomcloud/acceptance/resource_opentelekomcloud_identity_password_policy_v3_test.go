@@ -0,0 +1,59 @@
+package acceptance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccIdentityPasswordPolicyV3_basic(t *testing.T) {
+	resourceName := "opentelekomcloud_identity_password_policy_v3.policy"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityPasswordPolicyV3_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "minimum_password_length", "12"),
+					resource.TestCheckResourceAttr(resourceName, "password_validity_period", "90"),
+					resource.TestCheckResourceAttr(resourceName, "number_of_recent_passwords_disallowed", "5"),
+				),
+			},
+			{
+				Config: testAccIdentityPasswordPolicyV3_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "minimum_password_length", "16"),
+					resource.TestCheckResourceAttr(resourceName, "password_validity_period", "180"),
+					resource.TestCheckResourceAttr(resourceName, "maximum_consecutive_identical_chars", "3"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccIdentityPasswordPolicyV3_basic = `
+resource "opentelekomcloud_identity_password_policy_v3" "policy" {
+  minimum_password_length               = 12
+  password_validity_period              = 90
+  number_of_recent_passwords_disallowed = 5
+}
+`
+
+const testAccIdentityPasswordPolicyV3_update = `
+resource "opentelekomcloud_identity_password_policy_v3" "policy" {
+  minimum_password_length               = 16
+  password_validity_period              = 180
+  number_of_recent_passwords_disallowed = 5
+  maximum_consecutive_identical_chars   = 3
+}
+`
@@ -0,0 +1,67 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccIdentityProtocolV3_basic(t *testing.T) {
+	suffix := acctest.RandString(5)
+	resourceName := "opentelekomcloud_identity_protocol_v3.saml"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIdentityFederationV3Destroy("opentelekomcloud_identity_provider_v3", "identity_providers"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityProtocolV3_basic(suffix, "mapping_1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "protocol", "saml"),
+					resource.TestCheckResourceAttrPair(resourceName, "mapping_id", "opentelekomcloud_identity_mapping_v3.mapping_1", "id"),
+				),
+			},
+			{
+				Config: testAccIdentityProtocolV3_basic(suffix, "mapping_2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "mapping_id", "opentelekomcloud_identity_mapping_v3.mapping_2", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccIdentityProtocolV3_basic(suffix, mapping string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_identity_provider_v3" "provider" {
+  name = "tf_acc_provider_%[1]s"
+}
+
+resource "opentelekomcloud_identity_mapping_v3" "mapping_1" {
+  mapping_id = "tf_acc_mapping_1_%[1]s"
+  rules      = jsonencode([{ local = [{ user = { name = "{0}" } }], remote = [{ type = "UserName" }] }])
+}
+
+resource "opentelekomcloud_identity_mapping_v3" "mapping_2" {
+  mapping_id = "tf_acc_mapping_2_%[1]s"
+  rules      = jsonencode([{ local = [{ user = { name = "fed_{0}" } }], remote = [{ type = "UserName" }] }])
+}
+
+resource "opentelekomcloud_identity_protocol_v3" "saml" {
+  protocol    = "saml"
+  provider_id = opentelekomcloud_identity_provider_v3.provider.id
+  mapping_id  = opentelekomcloud_identity_mapping_v3.%[2]s.id
+}
+`, suffix, mapping)
+}
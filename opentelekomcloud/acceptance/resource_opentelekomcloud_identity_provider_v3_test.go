@@ -0,0 +1,108 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func TestAccIdentityProviderV3_basic(t *testing.T) {
+	name := fmt.Sprintf("tf_acc_provider_%s", acctest.RandString(5))
+	resourceName := "opentelekomcloud_identity_provider_v3.provider"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIdentityFederationV3Destroy("opentelekomcloud_identity_provider_v3", "identity_providers"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityProviderV3_basic(name, "created", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityFederationV3Exists(resourceName, "identity_providers"),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "description", "created"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+			{
+				Config: testAccIdentityProviderV3_basic(name, "updated", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityFederationV3Exists(resourceName, "identity_providers"),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testAccCheckIdentityFederationV3Destroy checks that federation resources of `resourceType` stored in
+// `OS-FEDERATION/<collection>` don't exist anymore
+func testAccCheckIdentityFederationV3Destroy(resourceType, collection string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*cfg.Config)
+		client, err := config.IdentityV3Client()
+		if err != nil {
+			return fmt.Errorf("error creating OpenTelekomCloud identity client: %s", err)
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+			url := client.ServiceURL("OS-FEDERATION", collection, rs.Primary.ID)
+			_, err := client.Get(url, nil, nil)
+			if err == nil {
+				return fmt.Errorf("%s %s still exists", resourceType, rs.Primary.ID)
+			}
+			if _, ok := err.(golangsdk.ErrDefault404); !ok {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func testAccCheckIdentityFederationV3Exists(n, collection string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		config := testAccProvider.Meta().(*cfg.Config)
+		client, err := config.IdentityV3Client()
+		if err != nil {
+			return fmt.Errorf("error creating OpenTelekomCloud identity client: %s", err)
+		}
+		_, err = client.Get(client.ServiceURL("OS-FEDERATION", collection, rs.Primary.ID), nil, nil)
+		return err
+	}
+}
+
+func testAccIdentityProviderV3_basic(name, description string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_identity_provider_v3" "provider" {
+  name        = "%s"
+  description = "%s"
+  enabled     = %t
+}
+`, name, description, enabled)
+}
@@ -43,6 +43,31 @@ func TestAccIdentityRoleV3_basic(t *testing.T) {
 	})
 }
 
+func TestAccIdentityRoleV3_policy(t *testing.T) {
+	resourceName := "opentelekomcloud_identity_role_v3.role"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIdentityRoleV3Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityRoleV3_policy(acctest.RandString(10)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityRoleV3Exists,
+					resource.TestCheckResourceAttr(resourceName, "statement.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "statement.0.action.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccIdentityRoleV3_basic(val string) string {
 	return fmt.Sprintf(`
 resource "opentelekomcloud_identity_role_v3" "role" {
@@ -114,3 +139,28 @@ func testAccCheckIdentityRoleV3Exists(s *terraform.State) error {
 	}
 	return nil
 }
+
+func testAccIdentityRoleV3_policy(val string) string {
+	return fmt.Sprintf(`
+data "opentelekomcloud_identity_policy_document_v3" "policy" {
+  statement {
+    effect   = "Allow"
+    action   = ["obs:object:GetObject", "obs:object:PutObject"]
+    resource = ["obs:*:*:object:tf-acc-bucket/*"]
+
+    condition {
+      operator = "StringStartWith"
+      key      = "g:ProjectName"
+      values   = ["eu-de"]
+    }
+  }
+}
+
+resource "opentelekomcloud_identity_role_v3" "role" {
+  description   = "role"
+  display_name  = "custom_role%s"
+  display_layer = "project"
+  policy        = data.opentelekomcloud_identity_policy_document_v3.policy.json
+}
+`, val)
+}
@@ -47,6 +47,12 @@ func TestAccIdentityV3User_basic(t *testing.T) {
 						"opentelekomcloud_identity_user_v3.user_1", "enabled", "false"),
 					resource.TestCheckResourceAttr(
 						"opentelekomcloud_identity_user_v3.user_1", "email", "test2@acme.org"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_identity_user_v3.user_1", "description", "Some user"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_identity_user_v3.user_1", "phone", "0049-123456789"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_identity_user_v3.user_1", "access_mode", "console"),
 				),
 			},
 		},
@@ -124,6 +130,10 @@ func testAccIdentityV3User_update(userName string) string {
       enabled = false
       password = "password123@!"
       email = "tEst2@acme.org"
+      description = "Some user"
+      phone = "0049-123456789"
+      access_mode = "console"
+      pwd_reset = true
     }
   `, userName)
 }
@@ -0,0 +1,68 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccKmsCiphertextV1_basic(t *testing.T) {
+	keyAlias := fmt.Sprintf("kms_%s", acctest.RandString(5))
+	resourceName := "opentelekomcloud_kms_ciphertext_v1.cipher"
+	dataSourceName := "data.opentelekomcloud_kms_secrets_v1.secrets"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKmsV1KeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKmsCiphertextV1_basic(keyAlias, "some-secret"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "ciphertext_blob"),
+					resource.TestCheckResourceAttr(resourceName, "encryption_context.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "encryption_context.service", "rds"),
+					resource.TestCheckResourceAttr(dataSourceName, "plaintext.secret", "some-secret"),
+				),
+			},
+			{
+				Config: testAccKmsCiphertextV1_basic(keyAlias, "another-secret"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "ciphertext_blob"),
+					resource.TestCheckResourceAttr(dataSourceName, "plaintext.secret", "another-secret"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKmsCiphertextV1_basic(keyAlias, plaintext string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_kms_key_v1" "key_1" {
+  key_alias    = "%s"
+  pending_days = "7"
+}
+
+resource "opentelekomcloud_kms_ciphertext_v1" "cipher" {
+  key_id    = opentelekomcloud_kms_key_v1.key_1.id
+  plaintext = "%s"
+
+  encryption_context = {
+    service = "rds"
+  }
+}
+
+data "opentelekomcloud_kms_secrets_v1" "secrets" {
+  secret {
+    name    = "secret"
+    payload = opentelekomcloud_kms_ciphertext_v1.cipher.ciphertext_blob
+
+    encryption_context = {
+      service = "rds"
+    }
+  }
+}
+`, keyAlias, plaintext)
+}
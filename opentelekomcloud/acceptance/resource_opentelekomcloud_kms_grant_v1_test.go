@@ -0,0 +1,140 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func TestAccKmsGrantV1_basic(t *testing.T) {
+	keyAlias := fmt.Sprintf("kms_%s", acctest.RandString(5))
+	userName := fmt.Sprintf("kms_grantee_%s", acctest.RandString(5))
+	resourceName := "opentelekomcloud_kms_grant_v1.grant_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKmsV1GrantDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKmsV1Grant_basic(keyAlias, userName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKmsV1GrantExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "grant_1"),
+					resource.TestCheckResourceAttr(resourceName, "operations.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "grantee_principal", "opentelekomcloud_identity_user_v3.user_1", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// not returned by some API versions
+				ImportStateVerifyIgnore: []string{"grantee_principal_type"},
+			},
+		},
+	})
+}
+
+func testAccCheckKmsV1GrantDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*cfg.Config)
+	client, err := config.KmsKeyV1Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud KMSv1 client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_kms_grant_v1" {
+			continue
+		}
+		found, err := findKmsV1Grant(client, rs.Primary.Attributes["key_id"], rs.Primary.ID)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				continue
+			}
+			return err
+		}
+		if found {
+			return fmt.Errorf("KMS grant still exists")
+		}
+	}
+	return nil
+}
+
+func testAccCheckKmsV1GrantExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		config := testAccProvider.Meta().(*cfg.Config)
+		client, err := config.KmsKeyV1Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating OpenTelekomCloud KMSv1 client: %s", err)
+		}
+		found, err := findKmsV1Grant(client, rs.Primary.Attributes["key_id"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("KMS grant not found")
+		}
+		return nil
+	}
+}
+
+// findKmsV1Grant checks if the grant with the `<key_id>/<grant_id>` ID exists
+func findKmsV1Grant(client *golangsdk.ServiceClient, keyID, id string) (bool, error) {
+	var res struct {
+		Grants []struct {
+			GrantID string `json:"grant_id"`
+		} `json:"grants"`
+	}
+	url := client.ServiceURL(client.ProjectID, "kms", "list-grants")
+	_, err := client.Post(url, map[string]string{"key_id": keyID}, &res, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	if err != nil {
+		return false, err
+	}
+	for _, grant := range res.Grants {
+		if fmt.Sprintf("%s/%s", keyID, grant.GrantID) == id {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func testAccKmsV1Grant_basic(keyAlias, userName string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_kms_key_v1" "key_1" {
+  key_alias    = "%s"
+  pending_days = "7"
+}
+
+resource "opentelekomcloud_identity_user_v3" "user_1" {
+  name     = "%s"
+  password = "password123@!"
+  enabled  = true
+}
+
+resource "opentelekomcloud_kms_grant_v1" "grant_1" {
+  key_id            = opentelekomcloud_kms_key_v1.key_1.id
+  name              = "grant_1"
+  grantee_principal = opentelekomcloud_identity_user_v3.user_1.id
+  operations        = ["describe-key", "encrypt-datakey"]
+}
+`, keyAlias, userName)
+}
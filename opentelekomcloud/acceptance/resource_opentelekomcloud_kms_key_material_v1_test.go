@@ -0,0 +1,94 @@
+package acceptance
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/kms/v1/keys"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/kms"
+)
+
+func TestAccKmsKeyMaterialV1_basic(t *testing.T) {
+	var key keys.Key
+	keyAlias := fmt.Sprintf("kms_%s", acctest.RandString(5))
+	material := make([]byte, 32)
+	if _, err := rand.Read(material); err != nil {
+		t.Fatal(err)
+	}
+	encoded := base64.StdEncoding.EncodeToString(material)
+	resourceName := "opentelekomcloud_kms_key_material_v1.material_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKmsV1KeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKmsV1KeyMaterial_basic(keyAlias, encoded),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKmsV1KeyExists("opentelekomcloud_kms_key_v1.key_1", &key),
+					resource.TestCheckResourceAttr("opentelekomcloud_kms_key_v1.key_1", "origin", "external"),
+					resource.TestCheckResourceAttr(resourceName, "key_state", kms.EnabledState),
+				),
+			},
+			{
+				Config: testAccKmsV1KeyMaterial_keyOnly(keyAlias),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKmsV1KeyState("opentelekomcloud_kms_key_v1.key_1", kms.PendingImportState),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKmsV1KeyState(n string, state string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		config := testAccProvider.Meta().(*cfg.Config)
+		client, err := config.KmsKeyV1Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating OpenTelekomCloud KMSv1 client: %s", err)
+		}
+		found, err := keys.Get(client, rs.Primary.ID).ExtractKeyInfo()
+		if err != nil {
+			return err
+		}
+		if found.KeyState != state {
+			return fmt.Errorf("expected key state %s, got %s", state, found.KeyState)
+		}
+		return nil
+	}
+}
+
+func testAccKmsV1KeyMaterial_keyOnly(keyAlias string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_kms_key_v1" "key_1" {
+  key_alias    = "%s"
+  pending_days = "7"
+  origin       = "external"
+}
+`, keyAlias)
+}
+
+func testAccKmsV1KeyMaterial_basic(keyAlias, material string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_kms_key_material_v1" "material_1" {
+  key_id          = opentelekomcloud_kms_key_v1.key_1.id
+  key_material    = "%s"
+  expiration_time = "2030-01-01T00:00:00Z"
+}
+`, testAccKmsV1KeyMaterial_keyOnly(keyAlias), material)
+}
@@ -2,6 +2,7 @@ package acceptance
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
@@ -134,6 +135,66 @@ func TestAccKmsKey_isEnabled(t *testing.T) {
 	})
 }
 
+func TestAccKmsKeyV1_rotation(t *testing.T) {
+	var key keys.Key
+	rName := fmt.Sprintf("kms_%s", acctest.RandString(5))
+	resourceName := "opentelekomcloud_kms_key_v1.key_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKmsV1KeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKmsV1Key_rotation(rName, true, 90),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKmsV1KeyExists(resourceName, &key),
+					resource.TestCheckResourceAttr(resourceName, "rotation_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_interval", "90"),
+				),
+			},
+			{
+				Config: testAccKmsV1Key_rotation(rName, true, 180),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKmsV1KeyExists(resourceName, &key),
+					resource.TestCheckResourceAttr(resourceName, "rotation_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_interval", "180"),
+				),
+			},
+			{
+				Config: testAccKmsV1Key_rotation(rName, false, 180),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKmsV1KeyExists(resourceName, &key),
+					resource.TestCheckResourceAttr(resourceName, "rotation_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKmsKeyV1_asymmetric(t *testing.T) {
+	var key keys.Key
+	rName := fmt.Sprintf("kms_%s", acctest.RandString(5))
+	resourceName := "opentelekomcloud_kms_key_v1.key_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKmsV1KeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKmsV1Key_asymmetric(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKmsV1KeyExists(resourceName, &key),
+					resource.TestCheckResourceAttr(resourceName, "key_spec", "EC_P256"),
+					resource.TestCheckResourceAttr(resourceName, "key_usage", "SIGN_VERIFY"),
+					resource.TestMatchResourceAttr(resourceName, "public_key", regexp.MustCompile(`^-----BEGIN PUBLIC KEY-----`)),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckKmsKeyIsEnabled(key *keys.Key, isEnabled bool) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if (key.KeyState == kms.EnabledState) != isEnabled {
@@ -187,3 +248,25 @@ resource "opentelekomcloud_kms_key_v1" "bar" {
   is_enabled      = false
 }`, prefix)
 }
+
+func testAccKmsV1Key_rotation(rName string, enabled bool, interval int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_kms_key_v1" "key_1" {
+  key_alias         = "%s"
+  pending_days      = "7"
+  rotation_enabled  = %t
+  rotation_interval = %d
+}
+`, rName, enabled, interval)
+}
+
+func testAccKmsV1Key_asymmetric(rName string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_kms_key_v1" "key_1" {
+  key_alias    = "%s"
+  pending_days = "7"
+  key_spec     = "EC_P256"
+  key_usage    = "SIGN_VERIFY"
+}
+`, rName)
+}
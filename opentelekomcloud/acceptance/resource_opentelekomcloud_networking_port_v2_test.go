@@ -58,6 +58,30 @@ func TestAccNetworkingV2Port_noip(t *testing.T) {
 	})
 }
 
+func TestAccNetworkingV2Port_ipv6(t *testing.T) {
+	var port ports.Port
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2PortDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2Port_ipv6,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2PortExists("opentelekomcloud_networking_port_v2.port_1", &port),
+					testAccCheckNetworkingV2PortCountFixedIPs(&port, 2),
+					resource.TestCheckResourceAttr("opentelekomcloud_networking_port_v2.port_1", "all_fixed_ipv6s.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"opentelekomcloud_networking_port_v2.port_1", "all_fixed_ipv6s.0",
+						"opentelekomcloud_networking_port_v2.port_1", "fixed_ip.1.ip_address",
+					),
+				),
+			},
+		},
+	})
+}
+
 func TestAccNetworkingV2Port_allowedAddressPairs(t *testing.T) {
 	var network networks.Network
 	var subnet subnets.Subnet
@@ -202,6 +226,32 @@ resource "opentelekomcloud_networking_port_v2" "port_1" {
 }
 `
 
+var testAccNetworkingV2Port_ipv6 = fmt.Sprintf(`
+resource "opentelekomcloud_vpc_subnet_v1" "subnet_1" {
+  name        = "subnet_ipv6"
+  cidr        = "192.168.199.0/24"
+  gateway_ip  = "192.168.199.1"
+  vpc_id      = "%s"
+  ipv6_enable = true
+}
+
+resource "opentelekomcloud_networking_port_v2" "port_1" {
+  name           = "port_1"
+  admin_state_up = "true"
+  network_id     = opentelekomcloud_vpc_subnet_v1.subnet_1.id
+
+  fixed_ip {
+    subnet_id  = opentelekomcloud_vpc_subnet_v1.subnet_1.subnet_id
+    ip_address = "192.168.199.23"
+  }
+
+  fixed_ip {
+    subnet_id  = opentelekomcloud_vpc_subnet_v1.subnet_1.ipv6_subnet_id
+    ip_address = cidrhost(opentelekomcloud_vpc_subnet_v1.subnet_1.ipv6_cidr, 23)
+  }
+}
+`, OS_VPC_ID)
+
 const testAccNetworkingV2Port_noip = `
 resource "opentelekomcloud_networking_network_v2" "network_1" {
   name = "network_1"
@@ -89,6 +89,27 @@ func TestAccNetworkingV2SecGroupRule_numericProtocol(t *testing.T) {
 	})
 }
 
+func TestAccNetworkingV2SecGroupRule_ipv6(t *testing.T) {
+	var secgroupRule rules.SecGroupRule
+	resourceName := "opentelekomcloud_networking_secgroup_rule_v2.secgroup_rule_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2SecGroupRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2SecGroupRule_lowerCaseCIDR,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2SecGroupRuleExists(resourceName, &secgroupRule),
+					resource.TestCheckResourceAttr(resourceName, "ethertype", "IPv6"),
+					resource.TestCheckResourceAttr(resourceName, "remote_ip_prefix", "2001:558:fc00::/39"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckNetworkingV2SecGroupRuleDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*cfg.Config)
 	networkingClient, err := config.NetworkingV2Client(OS_REGION_NAME)
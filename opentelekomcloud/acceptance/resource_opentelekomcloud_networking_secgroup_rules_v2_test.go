@@ -0,0 +1,175 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/security/groups"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/security/rules"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func TestAccNetworkingV2SecGroupRules_basic(t *testing.T) {
+	var secGroup groups.SecGroup
+	resourceName := "opentelekomcloud_networking_secgroup_rules_v2.rules_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2SecGroupRulesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2SecGroupRules_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2SecGroupExists(
+						"opentelekomcloud_networking_secgroup_v2.secgroup_1", &secGroup),
+					testAccCheckNetworkingV2SecGroupRulesCount(resourceName, 3),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "3"),
+				),
+			},
+			{
+				Config: testAccNetworkingV2SecGroupRules_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2SecGroupRulesCount(resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"parallel_requests"},
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingV2SecGroupRulesDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*cfg.Config)
+	client, err := config.NetworkingV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_networking_secgroup_rules_v2" {
+			continue
+		}
+
+		_, err := groups.Get(client, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("security group still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckNetworkingV2SecGroupRulesCount(n string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		config := testAccProvider.Meta().(*cfg.Config)
+		client, err := config.NetworkingV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating OpenTelekomCloud networking client: %s", err)
+		}
+
+		pages, err := rules.List(client, rules.ListOpts{SecGroupID: rs.Primary.ID}).AllPages()
+		if err != nil {
+			return err
+		}
+		found, err := rules.ExtractRules(pages)
+		if err != nil {
+			return err
+		}
+		if len(found) != expected {
+			return fmt.Errorf("expected %d security group rules, got %d", expected, len(found))
+		}
+
+		return nil
+	}
+}
+
+const testAccNetworkingV2SecGroupRules_basic = `
+resource "opentelekomcloud_networking_secgroup_v2" "secgroup_1" {
+  name        = "secgroup_rules_1"
+  description = "terraform security group rules acceptance test"
+}
+
+resource "opentelekomcloud_networking_secgroup_v2" "secgroup_2" {
+  name        = "secgroup_rules_2"
+  description = "terraform security group rules acceptance test"
+}
+
+resource "opentelekomcloud_networking_secgroup_rules_v2" "rules_1" {
+  security_group_id = opentelekomcloud_networking_secgroup_v2.secgroup_1.id
+
+  rule {
+    description      = "ssh"
+    direction        = "ingress"
+    ethertype        = "IPv4"
+    protocol         = "tcp"
+    port_range_min   = 22
+    port_range_max   = 22
+    remote_ip_prefix = "0.0.0.0/0"
+  }
+
+  rule {
+    direction       = "ingress"
+    ethertype       = "IPv4"
+    protocol        = "tcp"
+    port_range_min  = 80
+    port_range_max  = 80
+    remote_group_id = opentelekomcloud_networking_secgroup_v2.secgroup_2.id
+  }
+
+  rule {
+    direction        = "egress"
+    ethertype        = "IPv6"
+    remote_ip_prefix = "2001:558:FC00::/39"
+  }
+}
+`
+
+const testAccNetworkingV2SecGroupRules_update = `
+resource "opentelekomcloud_networking_secgroup_v2" "secgroup_1" {
+  name        = "secgroup_rules_1"
+  description = "terraform security group rules acceptance test"
+}
+
+resource "opentelekomcloud_networking_secgroup_v2" "secgroup_2" {
+  name        = "secgroup_rules_2"
+  description = "terraform security group rules acceptance test"
+}
+
+resource "opentelekomcloud_networking_secgroup_rules_v2" "rules_1" {
+  security_group_id = opentelekomcloud_networking_secgroup_v2.secgroup_1.id
+  parallel_requests = 2
+
+  rule {
+    description      = "ssh from internal network"
+    direction        = "ingress"
+    ethertype        = "IPv4"
+    protocol         = "tcp"
+    port_range_min   = 22
+    port_range_max   = 22
+    remote_ip_prefix = "192.168.0.0/16"
+  }
+
+  rule {
+    direction       = "ingress"
+    ethertype       = "IPv4"
+    protocol        = "tcp"
+    port_range_min  = 80
+    port_range_max  = 80
+    remote_group_id = opentelekomcloud_networking_secgroup_v2.secgroup_2.id
+  }
+}
+`
@@ -59,6 +59,39 @@ func TestAccObsBucketObject_source(t *testing.T) {
 	})
 }
 
+func TestAccObsBucketObject_multipart(t *testing.T) {
+	tmpFile, err := ioutil.TempFile("", "tf-acc-obs-obj-multipart")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	rInt := acctest.RandInt()
+	// write two parts of data to the tempfile
+	err = ioutil.WriteFile(tmpFile.Name(), make([]byte, 6*1024*1024), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckObsBucketObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObsBucketObject_configMultipart(rInt, tmpFile.Name()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObsBucketObjectExists("opentelekomcloud_obs_bucket_object.object"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_obs_bucket_object.object", "size", "6291456"),
+					resource.TestMatchResourceAttr(
+						"opentelekomcloud_obs_bucket_object.object", "etag", regexp.MustCompile(`^[0-9a-f]{32}-2$`)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccObsBucketObject_content(t *testing.T) {
 	rInt := acctest.RandInt()
 
@@ -229,6 +262,22 @@ resource "opentelekomcloud_obs_bucket_object" "object" {
 `, randInt, source)
 }
 
+func testAccObsBucketObject_configMultipart(randInt int, source string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_obs_bucket" "object_bucket" {
+  bucket = "tf-object-test-bucket-%d"
+}
+resource "opentelekomcloud_obs_bucket_object" "object" {
+  bucket              = opentelekomcloud_obs_bucket.object_bucket.bucket
+  key                 = "test-key"
+  source              = "%s"
+  source_hash         = filemd5("%s")
+  multipart_threshold = 5242880
+  part_size           = 5242880
+}
+`, randInt, source, source)
+}
+
 func testAccObsBucketObject_configContent(randInt int) string {
 	return fmt.Sprintf(`
 resource "opentelekomcloud_obs_bucket" "object_bucket" {
@@ -214,6 +214,126 @@ func TestAccObsBucket_cors(t *testing.T) {
 	})
 }
 
+func TestAccObsBucket_encryption(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "opentelekomcloud_obs_bucket.bucket"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckObsBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObsBucketConfigWithEncryption(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObsBucketExists(resourceName),
+					resource.TestCheckResourceAttr(
+						resourceName, "server_side_encryption.0.algorithm", "kms"),
+					resource.TestCheckResourceAttrPair(
+						resourceName, "server_side_encryption.0.kms_key_id", "opentelekomcloud_kms_key_v1.key", "id"),
+				),
+			},
+			{
+				Config: testAccObsBucketConfigWithEncryptionAES(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						resourceName, "server_side_encryption.0.algorithm", "AES256"),
+				),
+			},
+			{
+				Config: testAccObsBucket_basic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						resourceName, "server_side_encryption.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccObsBucket_notifications(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "opentelekomcloud_obs_bucket.bucket"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckObsBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObsBucketConfigWithNotifications(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObsBucketExists(resourceName),
+					resource.TestCheckResourceAttr(
+						resourceName, "event_notifications.#", "1"),
+					resource.TestCheckResourceAttr(
+						resourceName, "event_notifications.0.events.#", "2"),
+					resource.TestCheckResourceAttr(
+						resourceName, "event_notifications.0.filter_rule.#", "1"),
+					resource.TestCheckResourceAttrSet(
+						resourceName, "event_notifications.0.id"),
+				),
+			},
+			{
+				Config: testAccObsBucket_basic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						resourceName, "event_notifications.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccObsBucket_wormQuota(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "opentelekomcloud_obs_bucket.bucket"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckObsBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObsBucketConfigWithWorm(rInt, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObsBucketExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "quota", "1073741824"),
+					resource.TestCheckResourceAttr(resourceName, "worm_policy.0.mode", "COMPLIANCE"),
+					resource.TestCheckResourceAttr(resourceName, "worm_policy.0.days", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_info.0.object_number", "0"),
+				),
+			},
+			{
+				Config: testAccObsBucketConfigWithWorm(rInt, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "worm_policy.0.days", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccObsBucket_parallelFS(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "opentelekomcloud_obs_bucket.bucket"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckObsBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObsBucketConfigParallelFS(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObsBucketExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "parallel_fs", "true"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckObsBucketDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*cfg.Config)
 	obsClient, err := config.NewObjectStorageClient(OS_REGION_NAME)
@@ -469,3 +589,85 @@ resource "opentelekomcloud_obs_bucket" "bucket" {
 }
 `, randInt)
 }
+
+func testAccObsBucketConfigWithEncryption(randInt int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_kms_key_v1" "key" {
+  key_alias    = "tf-test-bucket-key-%[1]d"
+  pending_days = "7"
+}
+
+resource "opentelekomcloud_obs_bucket" "bucket" {
+  bucket        = "tf-test-bucket-%[1]d"
+  storage_class = "STANDARD"
+  acl           = "private"
+
+  server_side_encryption {
+    algorithm  = "kms"
+    kms_key_id = opentelekomcloud_kms_key_v1.key.id
+  }
+}
+`, randInt)
+}
+
+func testAccObsBucketConfigWithEncryptionAES(randInt int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_obs_bucket" "bucket" {
+  bucket        = "tf-test-bucket-%d"
+  storage_class = "STANDARD"
+  acl           = "private"
+
+  server_side_encryption {
+    algorithm = "AES256"
+  }
+}
+`, randInt)
+}
+
+func testAccObsBucketConfigWithNotifications(randInt int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_smn_topic_v2" "topic" {
+  name = "tf-test-bucket-topic-%[1]d"
+}
+
+resource "opentelekomcloud_obs_bucket" "bucket" {
+  bucket        = "tf-test-bucket-%[1]d"
+  storage_class = "STANDARD"
+  acl           = "private"
+
+  event_notifications {
+    topic  = opentelekomcloud_smn_topic_v2.topic.id
+    events = ["ObjectCreated:*", "ObjectRemoved:*"]
+
+    filter_rule {
+      name  = "prefix"
+      value = "images/"
+    }
+  }
+}
+`, randInt)
+}
+
+func testAccObsBucketConfigWithWorm(randInt, days int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_obs_bucket" "bucket" {
+  bucket = "tf-test-bucket-%d"
+  acl    = "private"
+  quota  = 1073741824
+
+  worm_policy {
+    days = %d
+  }
+}
+`, randInt, days)
+}
+
+func testAccObsBucketConfigParallelFS(randInt int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_obs_bucket" "bucket" {
+  bucket      = "tf-test-bucket-%d"
+  acl         = "private"
+  parallel_fs = true
+}
+`, randInt)
+}
@@ -0,0 +1,167 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/rds"
+)
+
+func TestAccRdsBackupV3_basic(t *testing.T) {
+	postfix := acctest.RandString(3)
+	var backup rds.Backup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRdsBackupV3Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRdsBackupV3_basic(postfix),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRdsBackupV3Exists("opentelekomcloud_rds_backup_v3.backup", &backup),
+					resource.TestCheckResourceAttr("opentelekomcloud_rds_backup_v3.backup", "name", "tf_rds_backup_"+postfix),
+					resource.TestCheckResourceAttr("opentelekomcloud_rds_backup_v3.backup", "type", "manual"),
+					resource.TestCheckResourceAttr("opentelekomcloud_rds_backup_v3.backup", "status", "COMPLETED"),
+				),
+			},
+			{
+				ResourceName:      "opentelekomcloud_rds_backup_v3.backup",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccRdsBackupV3ImportStateIdFunc("opentelekomcloud_rds_backup_v3.backup"),
+			},
+		},
+	})
+}
+
+func TestAccRdsBackupV3_restore(t *testing.T) {
+	postfix := acctest.RandString(3)
+	var backup rds.Backup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRdsInstanceV3Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRdsBackupV3_restore(postfix),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRdsBackupV3Exists("opentelekomcloud_rds_backup_v3.backup", &backup),
+					resource.TestCheckResourceAttr("opentelekomcloud_rds_instance_v3.restored", "name", "tf_rds_restored_"+postfix),
+					resource.TestCheckResourceAttrPair(
+						"opentelekomcloud_rds_instance_v3.restored", "restore_point.0.backup_id",
+						"opentelekomcloud_rds_backup_v3.backup", "id",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRdsBackupV3Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*cfg.Config)
+	client, err := config.RdsV3Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating RDSv3 client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_rds_backup_v3" {
+			continue
+		}
+		backup, _ := rds.GetRdsBackup(client, rs.Primary.Attributes["instance_id"], rs.Primary.ID)
+		if backup != nil {
+			return fmt.Errorf("RDSv3 backup still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckRdsBackupV3Exists(n string, backup *rds.Backup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+		config := testAccProvider.Meta().(*cfg.Config)
+		client, err := config.RdsV3Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating RDSv3 client: %s", err)
+		}
+
+		found, err := rds.GetRdsBackup(client, rs.Primary.Attributes["instance_id"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if found == nil {
+			return fmt.Errorf("RDSv3 backup not found")
+		}
+
+		*backup = *found
+
+		return nil
+	}
+}
+
+func testAccRdsBackupV3ImportStateIdFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", n)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["instance_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccRdsBackupV3_basic(postfix string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_rds_backup_v3" "backup" {
+  instance_id = opentelekomcloud_rds_instance_v3.instance.id
+  name        = "tf_rds_backup_%s"
+  description = "manual backup"
+}
+`, testAccRdsInstanceV3_basic(postfix), postfix)
+}
+
+func testAccRdsBackupV3_restore(postfix string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_rds_instance_v3" "restored" {
+  name              = "tf_rds_restored_%s"
+  availability_zone = ["%s"]
+  db {
+    password = "Postgres!120521"
+    type     = "PostgreSQL"
+    version  = "10"
+    port     = "8635"
+  }
+  security_group_id = opentelekomcloud_networking_secgroup_v2.sg.id
+  subnet_id = "%s"
+  vpc_id    = "%s"
+  volume {
+    type = "COMMON"
+    size = 40
+  }
+  flavor = "rds.pg.c2.medium"
+
+  restore_point {
+    instance_id = opentelekomcloud_rds_instance_v3.instance.id
+    backup_id   = opentelekomcloud_rds_backup_v3.backup.id
+  }
+}
+`, testAccRdsBackupV3_basic(postfix), postfix, OS_AVAILABILITY_ZONE, OS_NETWORK_ID, OS_VPC_ID)
+}
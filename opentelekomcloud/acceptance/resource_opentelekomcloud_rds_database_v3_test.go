@@ -0,0 +1,225 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/rds"
+)
+
+func TestAccRdsDatabaseV3_mysql(t *testing.T) {
+	postfix := acctest.RandString(3)
+	resourceName := "opentelekomcloud_rds_database_v3.database"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRdsDatabaseV3Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRdsDatabaseV3_mysql(postfix),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRdsDatabaseV3Exists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "tf_db_"+postfix),
+					resource.TestCheckResourceAttr(resourceName, "character_set", "utf8"),
+					testAccCheckRdsDatabaseV3Exists("opentelekomcloud_rds_db_user_v3.user"),
+					resource.TestCheckResourceAttr("opentelekomcloud_rds_db_privilege_v3.privilege", "users.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:            "opentelekomcloud_rds_db_user_v3.user",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+			{
+				ResourceName:      "opentelekomcloud_rds_db_privilege_v3.privilege",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRdsDatabaseV3_postgresql(t *testing.T) {
+	postfix := acctest.RandString(3)
+	resourceName := "opentelekomcloud_rds_database_v3.database"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRdsDatabaseV3Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRdsDatabaseV3_postgresql(postfix),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRdsDatabaseV3Exists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "tf_db_"+postfix),
+					resource.TestCheckResourceAttr(resourceName, "owner", "tf_user_"+postfix),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"template", "lc_collate", "lc_ctype"},
+			},
+		},
+	})
+}
+
+func testAccCheckRdsDatabaseV3Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*cfg.Config)
+	client, err := config.RdsV3Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating RDSv3 client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_rds_database_v3" {
+			continue
+		}
+		instance, _ := rds.GetRdsInstance(client, rs.Primary.Attributes["instance_id"])
+		if instance == nil {
+			continue
+		}
+		database, _ := rds.GetRdsDatabase(client, rs.Primary.Attributes["instance_id"], rs.Primary.Attributes["name"])
+		if database != nil {
+			return fmt.Errorf("RDSv3 database still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckRdsDatabaseV3Exists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+		config := testAccProvider.Meta().(*cfg.Config)
+		client, err := config.RdsV3Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating RDSv3 client: %s", err)
+		}
+
+		instanceID := rs.Primary.Attributes["instance_id"]
+		name := rs.Primary.Attributes["name"]
+		if rs.Type == "opentelekomcloud_rds_db_user_v3" {
+			found, err := rds.GetRdsDbUser(client, instanceID, name)
+			if err != nil {
+				return err
+			}
+			if found == nil {
+				return fmt.Errorf("RDSv3 database account not found")
+			}
+			return nil
+		}
+
+		found, err := rds.GetRdsDatabase(client, instanceID, name)
+		if err != nil {
+			return err
+		}
+		if found == nil {
+			return fmt.Errorf("RDSv3 database not found")
+		}
+
+		return nil
+	}
+}
+
+func testAccRdsDatabaseV3_mysql(postfix string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_networking_secgroup_v2" "sg" {
+  name = "sg-rds-test"
+}
+
+resource "opentelekomcloud_rds_instance_v3" "instance" {
+  name              = "tf_rds_instance_%[1]s"
+  availability_zone = ["%[2]s"]
+  db {
+    password = "MySql!120521"
+    type     = "MySQL"
+    version  = "8.0"
+    port     = "8635"
+  }
+  security_group_id = opentelekomcloud_networking_secgroup_v2.sg.id
+  subnet_id = "%[3]s"
+  vpc_id    = "%[4]s"
+  volume {
+    type = "COMMON"
+    size = 40
+  }
+  flavor = "rds.mysql.c2.medium"
+}
+
+resource "opentelekomcloud_rds_database_v3" "database" {
+  instance_id   = opentelekomcloud_rds_instance_v3.instance.id
+  name          = "tf_db_%[1]s"
+  character_set = "utf8"
+}
+
+resource "opentelekomcloud_rds_db_user_v3" "user" {
+  instance_id = opentelekomcloud_rds_instance_v3.instance.id
+  name        = "tf_user_%[1]s"
+  password    = "MySql!120521"
+}
+
+resource "opentelekomcloud_rds_db_privilege_v3" "privilege" {
+  instance_id = opentelekomcloud_rds_instance_v3.instance.id
+  db_name     = opentelekomcloud_rds_database_v3.database.name
+
+  users {
+    name     = opentelekomcloud_rds_db_user_v3.user.name
+    readonly = true
+  }
+}
+`, postfix, OS_AVAILABILITY_ZONE, OS_NETWORK_ID, OS_VPC_ID)
+}
+
+func testAccRdsDatabaseV3_postgresql(postfix string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_rds_db_user_v3" "user" {
+  instance_id = opentelekomcloud_rds_instance_v3.instance.id
+  name        = "tf_user_%[2]s"
+  password    = "Postgres!120521"
+}
+
+resource "opentelekomcloud_rds_database_v3" "database" {
+  instance_id   = opentelekomcloud_rds_instance_v3.instance.id
+  name          = "tf_db_%[2]s"
+  owner         = opentelekomcloud_rds_db_user_v3.user.name
+  character_set = "UTF8"
+  template      = "template0"
+  lc_collate    = "en_US.UTF-8"
+  lc_ctype      = "en_US.UTF-8"
+}
+
+resource "opentelekomcloud_rds_db_privilege_v3" "privilege" {
+  instance_id = opentelekomcloud_rds_instance_v3.instance.id
+  db_name     = opentelekomcloud_rds_database_v3.database.name
+
+  users {
+    name        = opentelekomcloud_rds_db_user_v3.user.name
+    schema_name = "public"
+  }
+}
+`, testAccRdsInstanceV3_basic(postfix), postfix)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/rds/v3/instances"
@@ -48,6 +49,42 @@ func TestAccRdsInstanceV3_basic(t *testing.T) {
 	})
 }
 
+func TestAccRdsInstanceV3_inPlaceUpdate(t *testing.T) {
+	postfix := acctest.RandString(3)
+	var rdsInstance instances.RdsInstanceResponse
+	resourceName := "opentelekomcloud_rds_instance_v3.instance"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRdsInstanceV3Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRdsInstanceV3_inPlaceUpdate(postfix, "sg_1", 3306, "02:00-03:00"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRdsInstanceV3Exists(resourceName, &rdsInstance),
+					resource.TestCheckResourceAttr(resourceName, "db.0.port", "3306"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_window.0.start_time", "02:00"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_window.0.end_time", "03:00"),
+					resource.TestCheckResourceAttrPair(resourceName, "security_group_id",
+						"opentelekomcloud_networking_secgroup_v2.sg_1", "id"),
+				),
+			},
+			{
+				Config: testAccRdsInstanceV3_inPlaceUpdate(postfix, "sg_2", 3307, "04:00-05:00"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRdsInstanceV3Exists(resourceName, &rdsInstance),
+					resource.TestCheckResourceAttr(resourceName, "db.0.port", "3307"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_window.0.start_time", "04:00"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_window.0.end_time", "05:00"),
+					resource.TestCheckResourceAttrPair(resourceName, "security_group_id",
+						"opentelekomcloud_networking_secgroup_v2.sg_2", "id"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRdsInstanceV3_ip(t *testing.T) {
 	postfix := acctest.RandString(3)
 	var rdsInstance instances.RdsInstanceResponse
@@ -309,6 +346,43 @@ resource "opentelekomcloud_rds_instance_v3" "instance" {
 `, postfix, OS_AVAILABILITY_ZONE, OS_NETWORK_ID, OS_VPC_ID)
 }
 
+func testAccRdsInstanceV3_inPlaceUpdate(postfix, sgName string, port int, window string) string {
+	parts := strings.Split(window, "-")
+	return fmt.Sprintf(`
+resource "opentelekomcloud_networking_secgroup_v2" "sg_1" {
+  name = "sg-rds-test-1"
+}
+
+resource "opentelekomcloud_networking_secgroup_v2" "sg_2" {
+  name = "sg-rds-test-2"
+}
+
+resource "opentelekomcloud_rds_instance_v3" "instance" {
+  name              = "tf_rds_instance_%s"
+  availability_zone = ["%s"]
+  db {
+    password = "MySql!120521"
+    type     = "MySQL"
+    version  = "8.0"
+    port     = %d
+  }
+  security_group_id = opentelekomcloud_networking_secgroup_v2.%s.id
+  subnet_id = "%s"
+  vpc_id    = "%s"
+  volume {
+    type = "COMMON"
+    size = 40
+  }
+  flavor     = "rds.mysql.c2.medium"
+  ssl_enable = true
+  maintenance_window {
+    start_time = "%s"
+    end_time   = "%s"
+  }
+}
+`, postfix, OS_AVAILABILITY_ZONE, port, sgName, OS_NETWORK_ID, OS_VPC_ID, parts[0], parts[1])
+}
+
 func testAccRdsInstanceV3_eip(postfix string) string {
 	return fmt.Sprintf(`
 resource "opentelekomcloud_networking_floatingip_v2" "fip_1" {}
@@ -0,0 +1,89 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccRdsParameterGroupApplyV3_basic(t *testing.T) {
+	postfix := acctest.RandString(3)
+	resourceName := "opentelekomcloud_rds_parametergroup_apply_v3.apply"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRdsInstanceV3Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRdsParameterGroupApplyV3_basic(postfix, "10"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "results.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "results.0.success", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "results.0.instance_id",
+						"opentelekomcloud_rds_instance_v3.instance", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "config_updated"),
+				),
+			},
+			{
+				Config: testAccRdsParameterGroupApplyV3_basic(postfix, "20"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "results.0.success", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRdsParameterGroupApplyV3_basic(postfix, maxConnections string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_networking_secgroup_v2" "sg" {
+  name = "sg-rds-test"
+}
+
+resource "opentelekomcloud_rds_parametergroup_v3" "pg" {
+  name = "pg-rds-test-%[1]s"
+  values = {
+    max_connections = "%[5]s"
+  }
+  datastore {
+    type    = "mysql"
+    version = "8.0"
+  }
+}
+
+resource "opentelekomcloud_rds_instance_v3" "instance" {
+  name              = "tf_rds_instance_%[1]s"
+  availability_zone = ["%[2]s"]
+  db {
+    password = "MySql!120521"
+    type     = "MySQL"
+    version  = "8.0"
+  }
+  security_group_id = opentelekomcloud_networking_secgroup_v2.sg.id
+  subnet_id         = "%[3]s"
+  vpc_id            = "%[4]s"
+  volume {
+    type = "COMMON"
+    size = 40
+  }
+  flavor = "rds.mysql.c2.medium"
+
+  lifecycle {
+    ignore_changes = [param_group_id]
+  }
+}
+
+resource "opentelekomcloud_rds_parametergroup_apply_v3" "apply" {
+  config_id           = opentelekomcloud_rds_parametergroup_v3.pg.id
+  instance_ids        = [opentelekomcloud_rds_instance_v3.instance.id]
+  restart_if_required = true
+
+  triggers = {
+    values = jsonencode(opentelekomcloud_rds_parametergroup_v3.pg.values)
+  }
+}
+`, postfix, OS_AVAILABILITY_ZONE, OS_NETWORK_ID, OS_VPC_ID, maxConnections)
+}
@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 	"os"
 	"reflect"
+	"regexp"
 	"sort"
 	"testing"
 
@@ -16,7 +17,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/s3"
 
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
-	s3s "github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/s3"
+	obss "github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/obs"
 )
 
 func TestAccS3BucketObject_source(t *testing.T) {
@@ -47,6 +48,38 @@ func TestAccS3BucketObject_source(t *testing.T) {
 	})
 }
 
+func TestAccS3BucketObject_multipart(t *testing.T) {
+	tmpFile, err := ioutil.TempFile("", "tf-acc-s3-obj-multipart")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	rInt := acctest.RandInt()
+	// write two parts of data to the tempfile
+	err = ioutil.WriteFile(tmpFile.Name(), make([]byte, 6*1024*1024), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var obj s3.GetObjectOutput
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckS3(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckS3BucketObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccS3BucketObjectConfig_multipart(rInt, tmpFile.Name()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckS3BucketObjectExists("opentelekomcloud_s3_bucket_object.object", &obj),
+					resource.TestMatchResourceAttr(
+						"opentelekomcloud_s3_bucket_object.object", "etag", regexp.MustCompile(`^[0-9a-f]{32}-2$`)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccS3BucketObject_content(t *testing.T) {
 	rInt := acctest.RandInt()
 	var obj s3.GetObjectOutput
@@ -374,7 +407,7 @@ func testAccCheckS3BucketObjectAcl(n string, expectedPerms []string) resource.Te
 }
 
 func TestResourceS3BucketObjectAcl_validation(t *testing.T) {
-	_, errors := s3s.ValidateS3BucketObjectAclType("incorrect", "acl")
+	_, errors := obss.ValidateS3BucketObjectAclType("incorrect", "acl")
 	if len(errors) == 0 {
 		t.Fatalf("Expected to trigger a validation error")
 	}
@@ -394,7 +427,7 @@ func TestResourceS3BucketObjectAcl_validation(t *testing.T) {
 	}
 
 	for _, tc := range testCases {
-		_, errors := s3s.ValidateS3BucketObjectAclType(tc.Value, "acl")
+		_, errors := obss.ValidateS3BucketObjectAclType(tc.Value, "acl")
 		if len(errors) != tc.ErrCount {
 			t.Fatalf("Expected not to trigger a validation error")
 		}
@@ -475,6 +508,22 @@ resource "opentelekomcloud_s3_bucket_object" "object" {
 `, randInt, source)
 }
 
+func testAccS3BucketObjectConfig_multipart(randInt int, source string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_s3_bucket" "object_bucket" {
+  bucket = "tf-object-test-bucket-%d"
+}
+resource "opentelekomcloud_s3_bucket_object" "object" {
+  bucket              = opentelekomcloud_s3_bucket.object_bucket.bucket
+  key                 = "test-key"
+  source              = "%s"
+  source_hash         = filemd5("%s")
+  multipart_threshold = 5242880
+  part_size           = 5242880
+}
+`, randInt, source, source)
+}
+
 func testAccS3BucketObjectConfig_withContentCharacteristics(randInt int, source string) string {
 	return fmt.Sprintf(`
 resource "opentelekomcloud_s3_bucket" "object_bucket_2" {
@@ -22,7 +22,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
-	s3s "github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/s3"
+	obss "github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/obs"
 )
 
 func TestAccS3Bucket_basic(t *testing.T) {
@@ -567,7 +567,7 @@ func TestS3BucketName(t *testing.T) {
 	}
 
 	for _, v := range validDnsNames {
-		if err := s3s.ValidateS3BucketName(v, "us-west-2"); err != nil {
+		if err := obss.ValidateS3BucketName(v, "us-west-2"); err != nil {
 			t.Fatalf("%q should be a valid S3 bucket name", v)
 		}
 	}
@@ -584,7 +584,7 @@ func TestS3BucketName(t *testing.T) {
 	}
 
 	for _, v := range invalidDnsNames {
-		if err := s3s.ValidateS3BucketName(v, "us-west-2"); err == nil {
+		if err := obss.ValidateS3BucketName(v, "us-west-2"); err == nil {
 			t.Fatalf("%q should not be a valid S3 bucket name", v)
 		}
 	}
@@ -601,7 +601,7 @@ func TestS3BucketName(t *testing.T) {
 	}
 
 	for _, v := range validEastNames {
-		if err := s3s.ValidateS3BucketName(v, "us-east-1"); err != nil {
+		if err := obss.ValidateS3BucketName(v, "us-east-1"); err != nil {
 			t.Fatalf("%q should be a valid S3 bucket name", v)
 		}
 	}
@@ -612,7 +612,7 @@ func TestS3BucketName(t *testing.T) {
 	}
 
 	for _, v := range invalidEastNames {
-		if err := s3s.ValidateS3BucketName(v, "us-east-1"); err == nil {
+		if err := obss.ValidateS3BucketName(v, "us-east-1"); err == nil {
 			t.Fatalf("%q should not be a valid S3 bucket name", v)
 		}
 	}
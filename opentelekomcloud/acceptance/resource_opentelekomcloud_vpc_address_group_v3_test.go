@@ -0,0 +1,211 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func TestAccVpcAddressGroupV3_basic(t *testing.T) {
+	resourceName := "opentelekomcloud_vpc_address_group_v3.group_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcAddressGroupV3Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcAddressGroupV3_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcAddressGroupV3Exists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "address_group_1"),
+					resource.TestCheckResourceAttr(resourceName, "ip_version", "4"),
+					resource.TestCheckResourceAttr(resourceName, "address.#", "3"),
+				),
+			},
+			{
+				Config: testAccVpcAddressGroupV3_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcAddressGroupV3Exists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "address_group_2"),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated address group"),
+					resource.TestCheckResourceAttr(resourceName, "address.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVpcAddressGroupV3_ipv6(t *testing.T) {
+	resourceName := "opentelekomcloud_vpc_address_group_v3.group_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcAddressGroupV3Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcAddressGroupV3_ipv6,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcAddressGroupV3Exists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ip_version", "6"),
+					resource.TestCheckResourceAttr(resourceName, "address.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVpcAddressGroupV3_secGroupRule(t *testing.T) {
+	resourceName := "opentelekomcloud_networking_secgroup_rule_v2.rule_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcAddressGroupV3Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcAddressGroupV3_secGroupRule,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcAddressGroupV3Exists("opentelekomcloud_vpc_address_group_v3.group_1"),
+					resource.TestCheckResourceAttrPair(resourceName, "remote_address_group_id",
+						"opentelekomcloud_vpc_address_group_v3.group_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVpcAddressGroupV3Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*cfg.Config)
+	client, err := config.NetworkingV3Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud NetworkingV3 client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_vpc_address_group_v3" {
+			continue
+		}
+
+		url := client.ServiceURL(client.ProjectID, "vpc", "address-groups", rs.Primary.ID)
+		_, err := client.Get(url, nil, nil)
+		if err == nil {
+			return fmt.Errorf("VPC address group still exists")
+		}
+		if _, ok := err.(golangsdk.ErrDefault404); !ok {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckVpcAddressGroupV3Exists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		config := testAccProvider.Meta().(*cfg.Config)
+		client, err := config.NetworkingV3Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating OpenTelekomCloud NetworkingV3 client: %s", err)
+		}
+
+		url := client.ServiceURL(client.ProjectID, "vpc", "address-groups", rs.Primary.ID)
+		_, err = client.Get(url, nil, nil)
+		return err
+	}
+}
+
+const testAccVpcAddressGroupV3_basic = `
+resource "opentelekomcloud_vpc_address_group_v3" "group_1" {
+  name = "address_group_1"
+
+  address {
+    ip          = "192.168.10.10"
+    description = "single address"
+  }
+
+  address {
+    ip          = "192.168.20.0/24"
+    description = "network"
+  }
+
+  address {
+    ip = "192.168.30.1-192.168.30.100"
+  }
+}
+`
+
+const testAccVpcAddressGroupV3_update = `
+resource "opentelekomcloud_vpc_address_group_v3" "group_1" {
+  name        = "address_group_2"
+  description = "updated address group"
+
+  address {
+    ip          = "192.168.10.10"
+    description = "updated single address"
+  }
+
+  address {
+    ip = "192.168.40.0/24"
+  }
+}
+`
+
+const testAccVpcAddressGroupV3_ipv6 = `
+resource "opentelekomcloud_vpc_address_group_v3" "group_1" {
+  name       = "address_group_ipv6"
+  ip_version = 6
+
+  address {
+    ip = "2001:DB8::1"
+  }
+
+  address {
+    ip          = "2001:db8:1::/64"
+    description = "network"
+  }
+}
+`
+
+const testAccVpcAddressGroupV3_secGroupRule = `
+resource "opentelekomcloud_vpc_address_group_v3" "group_1" {
+  name = "address_group_rule"
+
+  address {
+    ip = "192.168.10.10"
+  }
+}
+
+resource "opentelekomcloud_networking_secgroup_v2" "secgroup_1" {
+  name = "secgroup_address_group"
+}
+
+resource "opentelekomcloud_networking_secgroup_rule_v2" "rule_1" {
+  direction               = "ingress"
+  ethertype               = "IPv4"
+  protocol                = "tcp"
+  port_range_min          = 443
+  port_range_max          = 443
+  remote_address_group_id = opentelekomcloud_vpc_address_group_v3.group_1.id
+  security_group_id       = opentelekomcloud_networking_secgroup_v2.secgroup_1.id
+}
+`
@@ -0,0 +1,165 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func TestAccVpcRouteTableV1_basic(t *testing.T) {
+	resourceName := "opentelekomcloud_vpc_route_table_v1.table_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcRouteTableV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcRouteTableV1_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcRouteTableV1Exists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "route_table_1"),
+					resource.TestCheckResourceAttr(resourceName, "default", "false"),
+					resource.TestCheckResourceAttr(resourceName, "subnets.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "route.#", "1"),
+				),
+			},
+			{
+				Config: testAccVpcRouteTableV1_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcRouteTableV1Exists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "route_table_2"),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated route table"),
+					resource.TestCheckResourceAttr(resourceName, "subnets.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "route.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckVpcRouteTableV1Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*cfg.Config)
+	client, err := config.NetworkingV1Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud NetworkingV1 client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_vpc_route_table_v1" {
+			continue
+		}
+
+		url := client.ServiceURL(client.ProjectID, "routetables", rs.Primary.ID)
+		_, err := client.Get(url, nil, nil)
+		if err == nil {
+			return fmt.Errorf("VPC route table still exists")
+		}
+		if _, ok := err.(golangsdk.ErrDefault404); !ok {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckVpcRouteTableV1Exists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		config := testAccProvider.Meta().(*cfg.Config)
+		client, err := config.NetworkingV1Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating OpenTelekomCloud NetworkingV1 client: %s", err)
+		}
+
+		url := client.ServiceURL(client.ProjectID, "routetables", rs.Primary.ID)
+		_, err = client.Get(url, nil, nil)
+		return err
+	}
+}
+
+const testAccVpcRouteTableV1_network = `
+resource "opentelekomcloud_vpc_v1" "vpc_1" {
+  name = "vpc_route_table"
+  cidr = "192.168.0.0/16"
+}
+
+resource "opentelekomcloud_vpc_subnet_v1" "subnet_1" {
+  name       = "subnet_route_table_1"
+  cidr       = "192.168.0.0/24"
+  gateway_ip = "192.168.0.1"
+  vpc_id     = opentelekomcloud_vpc_v1.vpc_1.id
+}
+
+resource "opentelekomcloud_vpc_subnet_v1" "subnet_2" {
+  name       = "subnet_route_table_2"
+  cidr       = "192.168.1.0/24"
+  gateway_ip = "192.168.1.1"
+  vpc_id     = opentelekomcloud_vpc_v1.vpc_1.id
+}
+
+resource "opentelekomcloud_networking_vip_v2" "vip_1" {
+  network_id = opentelekomcloud_vpc_subnet_v1.subnet_1.id
+}
+`
+
+var testAccVpcRouteTableV1_basic = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_vpc_route_table_v1" "table_1" {
+  name    = "route_table_1"
+  vpc_id  = opentelekomcloud_vpc_v1.vpc_1.id
+  subnets = [opentelekomcloud_vpc_subnet_v1.subnet_1.id]
+
+  route {
+    destination = "172.16.0.0/16"
+    type        = "vip"
+    nexthop     = opentelekomcloud_networking_vip_v2.vip_1.ip_address
+  }
+}
+`, testAccVpcRouteTableV1_network)
+
+var testAccVpcRouteTableV1_update = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_vpc_route_table_v1" "table_1" {
+  name        = "route_table_2"
+  description = "updated route table"
+  vpc_id      = opentelekomcloud_vpc_v1.vpc_1.id
+  subnets     = [
+    opentelekomcloud_vpc_subnet_v1.subnet_1.id,
+    opentelekomcloud_vpc_subnet_v1.subnet_2.id,
+  ]
+
+  route {
+    destination = "172.16.0.0/16"
+    type        = "vip"
+    nexthop     = opentelekomcloud_networking_vip_v2.vip_1.ip_address
+    description = "route to VIP"
+  }
+
+  route {
+    destination = "172.17.0.0/16"
+    type        = "vip"
+    nexthop     = opentelekomcloud_networking_vip_v2.vip_1.ip_address
+  }
+}
+`, testAccVpcRouteTableV1_network)
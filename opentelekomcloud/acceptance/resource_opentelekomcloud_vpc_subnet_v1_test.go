@@ -55,6 +55,41 @@ func TestAccOTCVpcSubnetV1_basic(t *testing.T) {
 	})
 }
 
+func TestAccOTCVpcSubnetV1_ipv6(t *testing.T) {
+	var subnet subnets.Subnet
+	resourceName := "opentelekomcloud_vpc_subnet_v1.subnet_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOTCVpcSubnetV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOTCVpcSubnetV1_dhcp,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOTCVpcSubnetV1Exists(resourceName, &subnet),
+					resource.TestCheckResourceAttr(resourceName, "ipv6_enable", "false"),
+					resource.TestCheckResourceAttr(resourceName, "ipv6_cidr", ""),
+					resource.TestCheckResourceAttr(resourceName, "dhcp_lease_time", "48h"),
+					resource.TestCheckResourceAttr(resourceName, "dhcp_domain_name", "example.com"),
+				),
+			},
+			{
+				Config: testAccOTCVpcSubnetV1_ipv6,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOTCVpcSubnetV1Exists(resourceName, &subnet),
+					resource.TestCheckResourceAttr(resourceName, "ipv6_enable", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "ipv6_cidr"),
+					resource.TestCheckResourceAttrSet(resourceName, "ipv6_gateway"),
+					resource.TestCheckResourceAttrSet(resourceName, "ipv6_subnet_id"),
+					resource.TestCheckResourceAttr(resourceName, "dhcp_lease_time", "-1"),
+					resource.TestCheckResourceAttr(resourceName, "dhcp_domain_name", ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccOTCVpcSubnetV1_timeout(t *testing.T) {
 	var subnet subnets.Subnet
 
@@ -186,3 +221,37 @@ resource "opentelekomcloud_vpc_subnet_v1" "subnet_1" {
   }
 }
 `
+
+const testAccOTCVpcSubnetV1_dhcp = `
+resource "opentelekomcloud_vpc_v1" "vpc_1" {
+  name = "vpc_test"
+  cidr = "192.168.0.0/16"
+}
+
+resource "opentelekomcloud_vpc_subnet_v1" "subnet_1" {
+  name       = "opentelekomcloud_subnet"
+  cidr       = "192.168.0.0/16"
+  gateway_ip = "192.168.0.1"
+  vpc_id     = opentelekomcloud_vpc_v1.vpc_1.id
+
+  dhcp_lease_time  = "48h"
+  dhcp_domain_name = "example.com"
+}
+`
+
+const testAccOTCVpcSubnetV1_ipv6 = `
+resource "opentelekomcloud_vpc_v1" "vpc_1" {
+  name = "vpc_test"
+  cidr = "192.168.0.0/16"
+}
+
+resource "opentelekomcloud_vpc_subnet_v1" "subnet_1" {
+  name       = "opentelekomcloud_subnet"
+  cidr       = "192.168.0.0/16"
+  gateway_ip = "192.168.0.1"
+  vpc_id     = opentelekomcloud_vpc_v1.vpc_1.id
+
+  ipv6_enable     = true
+  dhcp_lease_time = "-1"
+}
+`
@@ -96,6 +96,72 @@ func TestAccOTCVpcV1_timeout(t *testing.T) {
 	})
 }
 
+func TestAccOTCVpcV1_enterpriseProject(t *testing.T) {
+	var vpc vpcs.Vpc
+	resourceName := "opentelekomcloud_vpc_v1.vpc_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOTCVpcV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcV1_enterpriseProject("project_1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOTCVpcV1Exists(resourceName, &vpc),
+					resource.TestCheckResourceAttrPair(
+						resourceName, "enterprise_project_id", "opentelekomcloud_enterprise_project.project_1", "id"),
+				),
+			},
+			{
+				Config: testAccVpcV1_enterpriseProject("project_2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOTCVpcV1Exists(resourceName, &vpc),
+					resource.TestCheckResourceAttrPair(
+						resourceName, "enterprise_project_id", "opentelekomcloud_enterprise_project.project_2", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOTCVpcV1_secondaryCIDRs(t *testing.T) {
+	var vpc vpcs.Vpc
+	resourceName := "opentelekomcloud_vpc_v1.vpc_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOTCVpcV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcV1_secondaryCIDRs(`"172.16.0.0/16"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOTCVpcV1Exists(resourceName, &vpc),
+					resource.TestCheckResourceAttr(resourceName, "secondary_cidrs.#", "1"),
+				),
+			},
+			{
+				Config: testAccVpcV1_secondaryCIDRs(`"172.17.0.0/16", "172.18.0.0/16"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOTCVpcV1Exists(resourceName, &vpc),
+					resource.TestCheckResourceAttr(resourceName, "secondary_cidrs.#", "2"),
+				),
+			},
+			{
+				Config: testAccVpcV1_secondaryCIDRs(""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOTCVpcV1Exists(resourceName, &vpc),
+					resource.TestCheckResourceAttr(resourceName, "secondary_cidrs.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckOTCVpcV1Destroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*cfg.Config)
 	vpcClient, err := config.NetworkingV1Client(OS_REGION_NAME)
@@ -186,3 +252,33 @@ resource "opentelekomcloud_vpc_v1" "vpc_1" {
   }
 }
 `
+
+func testAccVpcV1_enterpriseProject(project string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_enterprise_project" "project_1" {
+  name = "tf_acc_vpc_eps_1"
+}
+
+resource "opentelekomcloud_enterprise_project" "project_2" {
+  name = "tf_acc_vpc_eps_2"
+}
+
+resource "opentelekomcloud_vpc_v1" "vpc_1" {
+  name = "terraform_provider_test"
+  cidr = "192.168.0.0/16"
+
+  enterprise_project_id = opentelekomcloud_enterprise_project.%s.id
+}
+`, project)
+}
+
+func testAccVpcV1_secondaryCIDRs(cidrs string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_vpc_v1" "vpc_1" {
+  name = "terraform_provider_test"
+  cidr = "192.168.0.0/16"
+
+  secondary_cidrs = [%s]
+}
+`, cidrs)
+}
@@ -616,7 +616,9 @@ func (c *Config) setupTemporaryCredentials() error {
 	return nil
 }
 
-func (c *Config) NewObjectStorageClient(region string) (*obs.ObsClient, error) {
+// NewObjectStorageClient returns OBS client for the region, additional `configurers`
+// can be used to adjust client settings, e.g. signature type
+func (c *Config) NewObjectStorageClient(region string, configurers ...obs.Configurer) (*obs.ObsClient, error) {
 	if err := c.setupTemporaryCredentials(); err != nil {
 		return nil, fmt.Errorf("failed to construct OBS client without AK/SK: %s", err)
 	}
@@ -631,7 +633,8 @@ func (c *Config) NewObjectStorageClient(region string) (*obs.ObsClient, error) {
 
 	setUpOBSLogging()
 
-	return obs.New(c.AccessKey, c.SecretKey, client.Endpoint, obs.WithSecurityToken(c.SecurityToken))
+	configurers = append([]obs.Configurer{obs.WithSecurityToken(c.SecurityToken)}, configurers...)
+	return obs.New(c.AccessKey, c.SecretKey, client.Endpoint, configurers...)
 }
 
 func (c *Config) blockStorageV1Client(region string) (*golangsdk.ServiceClient, error) {
@@ -701,6 +704,20 @@ func (c *Config) IdentityV30Client() (*golangsdk.ServiceClient, error) {
 	return service, nil
 }
 
+// EpsV1Client - provides client for the global Enterprise Project Management Service which is missing in the SDK
+func (c *Config) EpsV1Client() (*golangsdk.ServiceClient, error) {
+	service, err := openstack.NewIdentityV3(c.DomainClient, golangsdk.EndpointOpts{
+		Availability: c.getEndpointType(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	endpoint := strings.Replace(service.Endpoint, "//iam.", "//eps.", 1)
+	service.Endpoint = strings.Replace(endpoint, "v3/", "v1.0/", 1)
+	service.Type = "eps"
+	return service, nil
+}
+
 func (c *Config) ImageV1Client(region string) (*golangsdk.ServiceClient, error) {
 	return openstack.NewImageServiceV1(c.HwClient, golangsdk.EndpointOpts{
 		Region:       region,
@@ -729,6 +746,19 @@ func (c *Config) NetworkingV2Client(region string) (*golangsdk.ServiceClient, er
 	})
 }
 
+// NetworkingV3Client - provides client for VPC v3 API used for VPC secondary CIDRs and IP address groups
+func (c *Config) NetworkingV3Client(region string) (*golangsdk.ServiceClient, error) {
+	service, err := openstack.NewNetworkV1(c.HwClient, golangsdk.EndpointOpts{
+		Region:       region,
+		Availability: c.getEndpointType(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	service.ResourceBase = service.Endpoint + "v3/"
+	return service, nil
+}
+
 func (c *Config) SmnV2Client(projectName ProjectName) (*golangsdk.ServiceClient, error) {
 	newConfig, err := reconfigProjectName(*c, projectName)
 	if err != nil {
@@ -827,6 +857,15 @@ func (c *Config) DmsV1Client(region string) (*golangsdk.ServiceClient, error) {
 	})
 }
 
+func (c *Config) DmsV2Client(region string) (*golangsdk.ServiceClient, error) {
+	client, err := c.DmsV1Client(region)
+	if err != nil {
+		return nil, err
+	}
+	client.ResourceBase = fmt.Sprintf("%sv2/%s/", client.Endpoint, c.HwClient.ProjectID)
+	return client, nil
+}
+
 func (c *Config) MrsV1Client(region string) (*golangsdk.ServiceClient, error) {
 	return openstack.NewMapReduceV1(c.HwClient, golangsdk.EndpointOpts{
 		Region:       region,
@@ -896,6 +935,15 @@ func (c *Config) DcsV1Client(region string) (*golangsdk.ServiceClient, error) {
 	})
 }
 
+func (c *Config) DcsV2Client(region string) (*golangsdk.ServiceClient, error) {
+	client, err := c.DcsV1Client(region)
+	if err != nil {
+		return nil, err
+	}
+	client.ResourceBase = fmt.Sprintf("%sv2/%s/", client.Endpoint, c.HwClient.ProjectID)
+	return client, nil
+}
+
 func (c *Config) RdsTagV1Client(region string) (*golangsdk.ServiceClient, error) {
 	return openstack.NewRdsTagV1(c.HwClient, golangsdk.EndpointOpts{
 		Region:       region,
@@ -1,6 +1,7 @@
 package common
 
 import (
+	"net"
 	"reflect"
 	"regexp"
 	"sort"
@@ -88,3 +89,48 @@ func SuppressEqualZoneNames(_, old, new string, _ *schema.ResourceData) bool {
 	newShort := strings.TrimSuffix(new, ".")
 	return oldShort == newShort
 }
+
+// SuppressEquivalentJsonDiffs suppresses diff of JSON strings differing only in formatting and key order
+func SuppressEquivalentJsonDiffs(_, old, new string, _ *schema.ResourceData) bool {
+	oldJson, err := NormalizeJsonString(old)
+	if err != nil {
+		return false
+	}
+	newJson, err := NormalizeJsonString(new)
+	if err != nil {
+		return false
+	}
+	return oldJson == newJson
+}
+
+// SuppressEquivalentIdentityPolicyDiffs suppresses diff of semantically equal IAM policy documents
+func SuppressEquivalentIdentityPolicyDiffs(_, old, new string, _ *schema.ResourceData) bool {
+	equivalent, err := IdentityPoliciesAreEquivalent(old, new)
+	if err != nil {
+		return false
+	}
+	return equivalent
+}
+
+// SuppressEquivalentIPDiffs suppresses diff of IP addresses having different representation, e.g. IPv6 `2001:DB8::1` and `2001:db8:0::1`
+func SuppressEquivalentIPDiffs(_, old, new string, _ *schema.ResourceData) bool {
+	oldIP := net.ParseIP(old)
+	newIP := net.ParseIP(new)
+	if oldIP == nil || newIP == nil {
+		return old == new
+	}
+	return oldIP.Equal(newIP)
+}
+
+// SuppressEquivalentCIDRDiffs suppresses diff of CIDRs having different representation, e.g. IPv6 `2001:DB8::/32` and `2001:db8:0::/32`
+func SuppressEquivalentCIDRDiffs(_, old, new string, _ *schema.ResourceData) bool {
+	oldIP, oldNet, err := net.ParseCIDR(old)
+	if err != nil {
+		return old == new
+	}
+	newIP, newNet, err := net.ParseCIDR(new)
+	if err != nil {
+		return false
+	}
+	return oldIP.Equal(newIP) && oldNet.String() == newNet.String()
+}
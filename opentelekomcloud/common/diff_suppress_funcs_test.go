@@ -0,0 +1,43 @@
+package common
+
+import (
+	"testing"
+)
+
+func TestSuppressEquivalentIPDiffs(t *testing.T) {
+	cases := []struct {
+		old, new   string
+		equivalent bool
+	}{
+		{"192.168.0.1", "192.168.0.1", true},
+		{"192.168.0.1", "192.168.0.2", false},
+		{"2001:DB8::1", "2001:db8:0::1", true},
+		{"2001:db8::1", "2001:db8::2", false},
+		{"", "", true},
+		{"", "192.168.0.1", false},
+	}
+	for _, c := range cases {
+		if SuppressEquivalentIPDiffs("", c.old, c.new, nil) != c.equivalent {
+			t.Errorf("expected %q and %q to be equivalent: %t", c.old, c.new, c.equivalent)
+		}
+	}
+}
+
+func TestSuppressEquivalentCIDRDiffs(t *testing.T) {
+	cases := []struct {
+		old, new   string
+		equivalent bool
+	}{
+		{"192.168.0.0/16", "192.168.0.0/16", true},
+		{"192.168.0.0/16", "192.168.0.0/24", false},
+		{"2001:DB8::/32", "2001:db8:0::/32", true},
+		{"2001:db8::/32", "2001:db8::/48", false},
+		{"", "", true},
+		{"", "::/0", false},
+	}
+	for _, c := range cases {
+		if SuppressEquivalentCIDRDiffs("", c.old, c.new, nil) != c.equivalent {
+			t.Errorf("expected %q and %q to be equivalent: %t", c.old, c.new, c.equivalent)
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package common
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+)
+
+// identityPolicyListKeys are statement elements which can be set either as a single string or as a list
+var identityPolicyListKeys = map[string]bool{
+	"Action":      true,
+	"NotAction":   true,
+	"Resource":    true,
+	"NotResource": true,
+}
+
+// IdentityPoliciesAreEquivalent checks if two IAM policy JSON documents are semantically equal.
+// Order of statements, actions, resources and condition values doesn't matter,
+// as well as a single string doesn't differ from a list with the only element.
+func IdentityPoliciesAreEquivalent(policy1, policy2 string) (bool, error) {
+	var p1, p2 interface{}
+	if err := json.Unmarshal([]byte(policy1), &p1); err != nil {
+		return false, err
+	}
+	if err := json.Unmarshal([]byte(policy2), &p2); err != nil {
+		return false, err
+	}
+	return reflect.DeepEqual(normalizeIdentityPolicy(p1), normalizeIdentityPolicy(p2)), nil
+}
+
+func normalizeIdentityPolicy(policy interface{}) interface{} {
+	document, ok := policy.(map[string]interface{})
+	if !ok {
+		return policy
+	}
+	result := make(map[string]interface{}, len(document))
+	for key, value := range document {
+		if key != "Statement" {
+			result[key] = value
+			continue
+		}
+		statements, ok := value.([]interface{})
+		if !ok {
+			statements = []interface{}{value}
+		}
+		normalized := make([]interface{}, len(statements))
+		for i, statement := range statements {
+			normalized[i] = normalizeIdentityPolicyStatement(statement)
+		}
+		result[key] = sortedPolicyList(normalized)
+	}
+	return result
+}
+
+func normalizeIdentityPolicyStatement(statement interface{}) interface{} {
+	elements, ok := statement.(map[string]interface{})
+	if !ok {
+		return statement
+	}
+	result := make(map[string]interface{}, len(elements))
+	for key, value := range elements {
+		switch {
+		case identityPolicyListKeys[key]:
+			result[key] = normalizePolicyList(value)
+		case key == "Condition":
+			result[key] = normalizeIdentityPolicyCondition(value)
+		default:
+			result[key] = value
+		}
+	}
+	return result
+}
+
+// normalizeIdentityPolicyCondition normalizes `{"<operator>": {"<key>": ["<value>"]}}` condition
+func normalizeIdentityPolicyCondition(condition interface{}) interface{} {
+	operators, ok := condition.(map[string]interface{})
+	if !ok {
+		return condition
+	}
+	result := make(map[string]interface{}, len(operators))
+	for operator, keys := range operators {
+		keyMap, ok := keys.(map[string]interface{})
+		if !ok {
+			result[operator] = keys
+			continue
+		}
+		normalizedKeys := make(map[string]interface{}, len(keyMap))
+		for key, values := range keyMap {
+			normalizedKeys[key] = normalizePolicyList(values)
+		}
+		result[operator] = normalizedKeys
+	}
+	return result
+}
+
+// normalizePolicyList converts single value to the list and sorts list,
+// maps (e.g. `{"uri": [...]}` resources of agency policies) are normalized recursively
+func normalizePolicyList(value interface{}) interface{} {
+	switch v := value.(type) {
+	case string:
+		return []interface{}{v}
+	case map[string]interface{}:
+		normalized := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			normalized[key] = normalizePolicyList(item)
+		}
+		return normalized
+	default:
+		return normalizePolicyValue(value)
+	}
+}
+
+func normalizePolicyValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case []interface{}:
+		normalized := make([]interface{}, len(v))
+		for i, item := range v {
+			normalized[i] = normalizePolicyValue(item)
+		}
+		return sortedPolicyList(normalized)
+	case map[string]interface{}:
+		normalized := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			normalized[key] = normalizePolicyValue(item)
+		}
+		return normalized
+	default:
+		return value
+	}
+}
+
+func sortedPolicyList(list []interface{}) []interface{} {
+	keys := make([]string, len(list))
+	for i, item := range list {
+		encoded, _ := json.Marshal(item)
+		keys[i] = string(encoded)
+	}
+	sort.Sort(policyListSorter{keys: keys, list: list})
+	return list
+}
+
+type policyListSorter struct {
+	keys []string
+	list []interface{}
+}
+
+func (s policyListSorter) Len() int           { return len(s.list) }
+func (s policyListSorter) Less(i, j int) bool { return s.keys[i] < s.keys[j] }
+func (s policyListSorter) Swap(i, j int) {
+	s.keys[i], s.keys[j] = s.keys[j], s.keys[i]
+	s.list[i], s.list[j] = s.list[j], s.list[i]
+}
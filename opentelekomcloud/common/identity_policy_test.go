@@ -0,0 +1,76 @@
+package common
+
+import (
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestIdentityPoliciesAreEquivalent(t *testing.T) {
+	cases := []struct {
+		name       string
+		policy1    string
+		policy2    string
+		equivalent bool
+	}{
+		{
+			name: "reordered actions and statements",
+			policy1: `{"Version":"1.1","Statement":[
+				{"Effect":"Allow","Action":["ecs:*:list*","ecs:*:get*"]},
+				{"Effect":"Deny","Action":["ecs:*:delete*"]}]}`,
+			policy2: `{"Statement":[
+				{"Action":["ecs:*:delete*"],"Effect":"Deny"},
+				{"Action":["ecs:*:get*","ecs:*:list*"],"Effect":"Allow"}],"Version":"1.1"}`,
+			equivalent: true,
+		},
+		{
+			name:       "single action as string",
+			policy1:    `{"Version":"1.1","Statement":[{"Effect":"Allow","Action":"obs:*:*"}]}`,
+			policy2:    `{"Version":"1.1","Statement":[{"Effect":"Allow","Action":["obs:*:*"]}]}`,
+			equivalent: true,
+		},
+		{
+			name: "reordered resources and condition values",
+			policy1: `{"Version":"1.1","Statement":[{"Effect":"Allow","Action":["obs:object:*"],
+				"Resource":["obs:*:*:object:a/*","obs:*:*:object:b/*"],
+				"Condition":{"StringEquals":{"g:UserName":["u1","u2"]}}}]}`,
+			policy2: `{"Version":"1.1","Statement":[{"Effect":"Allow","Action":["obs:object:*"],
+				"Resource":["obs:*:*:object:b/*","obs:*:*:object:a/*"],
+				"Condition":{"StringEquals":{"g:UserName":["u2","u1"]}}}]}`,
+			equivalent: true,
+		},
+		{
+			name:       "agency resource uri",
+			policy1:    `{"Version":"1.1","Statement":[{"Effect":"Allow","Action":["iam:agencies:assume"],"Resource":{"uri":["/iam/agencies/b","/iam/agencies/a"]}}]}`,
+			policy2:    `{"Version":"1.1","Statement":[{"Effect":"Allow","Action":["iam:agencies:assume"],"Resource":{"uri":["/iam/agencies/a","/iam/agencies/b"]}}]}`,
+			equivalent: true,
+		},
+		{
+			name:       "different effect",
+			policy1:    `{"Version":"1.1","Statement":[{"Effect":"Allow","Action":["ecs:*:*"]}]}`,
+			policy2:    `{"Version":"1.1","Statement":[{"Effect":"Deny","Action":["ecs:*:*"]}]}`,
+			equivalent: false,
+		},
+		{
+			name:       "different condition",
+			policy1:    `{"Version":"1.1","Statement":[{"Effect":"Allow","Action":["ecs:*:*"],"Condition":{"StringEquals":{"g:UserName":["u1"]}}}]}`,
+			policy2:    `{"Version":"1.1","Statement":[{"Effect":"Allow","Action":["ecs:*:*"],"Condition":{"StringEquals":{"g:UserName":["u2"]}}}]}`,
+			equivalent: false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			equivalent, err := IdentityPoliciesAreEquivalent(c.policy1, c.policy2)
+			th.AssertNoErr(t, err)
+			th.AssertEquals(t, c.equivalent, equivalent)
+		})
+	}
+}
+
+func TestIdentityPoliciesAreEquivalentInvalidJSON(t *testing.T) {
+	_, err := IdentityPoliciesAreEquivalent(`{"Version":"1.1"}`, `{`)
+	if err == nil {
+		t.Fatal("expected error for invalid JSON")
+	}
+}
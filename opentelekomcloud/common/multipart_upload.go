@@ -0,0 +1,226 @@
+package common
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultMultipartThreshold = 100 * 1024 * 1024
+	DefaultPartSize           = 16 * 1024 * 1024
+	MinPartSize               = 5 * 1024 * 1024
+	DefaultParallelParts      = 4
+	MaxParts                  = 10000
+
+	defaultPartRetries = 3
+)
+
+// MultipartUploader is implemented by the object storage clients for the single object upload
+type MultipartUploader interface {
+	// FindUpload returns ID of the unfinished upload of the object, empty string if there is none
+	FindUpload() (string, error)
+	InitiateUpload() (string, error)
+	// ListParts returns ETags of already uploaded parts by the part number
+	ListParts(uploadID string) (map[int]string, error)
+	UploadPart(uploadID string, partNumber int, body io.ReadSeeker, size int64, contentMD5 string) (string, error)
+	CompleteUpload(uploadID string, parts []UploadedPart) (*UploadResult, error)
+}
+
+// UploadedPart represents the part of the multipart upload
+type UploadedPart struct {
+	PartNumber int
+	ETag       string
+}
+
+// UploadResult represents the result of the completed upload
+type UploadResult struct {
+	ETag      string
+	VersionID string
+}
+
+// MultipartUploadOpts contains the settings of the multipart upload
+type MultipartUploadOpts struct {
+	PartSize      int64
+	ParallelParts int
+	Retries       int
+	// VerifyETag enables checking ETags against MD5 of the uploaded data,
+	// it should be disabled when ETag isn't MD5 of the data, e.g. for SSE-KMS encrypted objects
+	VerifyETag bool
+}
+
+type uploadPart struct {
+	number int
+	offset int64
+	size   int64
+	md5    []byte
+}
+
+// partMD5 calculates MD5 of the part data
+func partMD5(source io.ReaderAt, offset, size int64) ([]byte, error) {
+	hash := md5.New()
+	if _, err := io.Copy(hash, io.NewSectionReader(source, offset, size)); err != nil {
+		return nil, err
+	}
+	return hash.Sum(nil), nil
+}
+
+// MultipartETag returns the ETag of the object uploaded in parts with given MD5 sums
+func MultipartETag(sums [][]byte) string {
+	hash := md5.New()
+	for _, sum := range sums {
+		hash.Write(sum)
+	}
+	return fmt.Sprintf("%s-%d", hex.EncodeToString(hash.Sum(nil)), len(sums))
+}
+
+// MultipartUpload uploads `size` bytes of the `source` in parts. Unfinished upload of the object
+// is resumed skipping the parts which were already uploaded with the same content. Failed parts are
+// retried and the upload is kept unfinished on failure, so it can be resumed later.
+func MultipartUpload(uploader MultipartUploader, source io.ReaderAt, size int64, opts MultipartUploadOpts) (*UploadResult, error) {
+	if opts.PartSize <= 0 {
+		opts.PartSize = DefaultPartSize
+	}
+	if size/opts.PartSize >= MaxParts {
+		opts.PartSize = size/(MaxParts-1) + 1
+	}
+	if opts.ParallelParts <= 0 {
+		opts.ParallelParts = DefaultParallelParts
+	}
+	if opts.Retries <= 0 {
+		opts.Retries = defaultPartRetries
+	}
+
+	var parts []*uploadPart
+	for offset, number := int64(0), 1; offset < size || number == 1; offset, number = offset+opts.PartSize, number+1 {
+		partSize := opts.PartSize
+		if size-offset < partSize {
+			partSize = size - offset
+		}
+		sum, err := partMD5(source, offset, partSize)
+		if err != nil {
+			return nil, fmt.Errorf("error calculating MD5 of part %d: %s", number, err)
+		}
+		parts = append(parts, &uploadPart{number: number, offset: offset, size: partSize, md5: sum})
+	}
+
+	uploaded := make(map[int]string)
+	uploadID, err := uploader.FindUpload()
+	if err != nil {
+		return nil, fmt.Errorf("error looking for unfinished upload: %s", err)
+	}
+	if uploadID != "" {
+		log.Printf("[DEBUG] Resuming multipart upload %s", uploadID)
+		existing, err := uploader.ListParts(uploadID)
+		if err != nil {
+			return nil, fmt.Errorf("error listing uploaded parts: %s", err)
+		}
+		for _, part := range parts {
+			etag, ok := existing[part.number]
+			if ok && opts.VerifyETag && strings.Trim(etag, `"`) == hex.EncodeToString(part.md5) {
+				uploaded[part.number] = etag
+			}
+		}
+	} else {
+		uploadID, err = uploader.InitiateUpload()
+		if err != nil {
+			return nil, fmt.Errorf("error initiating multipart upload: %s", err)
+		}
+		log.Printf("[DEBUG] Initiated multipart upload %s", uploadID)
+	}
+
+	queue := make(chan *uploadPart)
+	done := make(chan struct{})
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	var uploadErr error
+
+	for i := 0; i < opts.ParallelParts; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for part := range queue {
+				etag, err := uploadPartWithRetries(uploader, uploadID, source, part, opts)
+				mu.Lock()
+				if err != nil {
+					if uploadErr == nil {
+						uploadErr = err
+						close(done)
+					}
+				} else {
+					uploaded[part.number] = etag
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+enqueue:
+	for _, part := range parts {
+		mu.Lock()
+		_, ok := uploaded[part.number]
+		mu.Unlock()
+		if ok {
+			log.Printf("[DEBUG] Part %d is already uploaded, skipping", part.number)
+			continue
+		}
+		select {
+		case queue <- part:
+		case <-done:
+			break enqueue
+		}
+	}
+	close(queue)
+	wg.Wait()
+
+	if uploadErr != nil {
+		return nil, fmt.Errorf("multipart upload %s failed, it will be resumed on the next run: %s", uploadID, uploadErr)
+	}
+
+	completed := make([]UploadedPart, len(parts))
+	sums := make([][]byte, len(parts))
+	for i, part := range parts {
+		completed[i] = UploadedPart{PartNumber: part.number, ETag: uploaded[part.number]}
+		sums[i] = part.md5
+	}
+	result, err := uploader.CompleteUpload(uploadID, completed)
+	if err != nil {
+		return nil, fmt.Errorf("error completing multipart upload %s: %s", uploadID, err)
+	}
+	result.ETag = strings.Trim(result.ETag, `"`)
+	if expected := MultipartETag(sums); opts.VerifyETag && result.ETag != expected {
+		return nil, fmt.Errorf("ETag of the uploaded object %s doesn't match expected %s", result.ETag, expected)
+	}
+	return result, nil
+}
+
+func uploadPartWithRetries(uploader MultipartUploader, uploadID string, source io.ReaderAt, part *uploadPart, opts MultipartUploadOpts) (string, error) {
+	contentMD5 := base64.StdEncoding.EncodeToString(part.md5)
+	expected := hex.EncodeToString(part.md5)
+
+	var err error
+	for attempt := 1; attempt <= opts.Retries; attempt++ {
+		if attempt > 1 {
+			time.Sleep(time.Duration(attempt-1) * time.Second)
+			log.Printf("[DEBUG] Retrying upload of part %d, attempt %d: %s", part.number, attempt, err)
+		}
+		var etag string
+		body := io.NewSectionReader(source, part.offset, part.size)
+		etag, err = uploader.UploadPart(uploadID, part.number, body, part.size, contentMD5)
+		if err != nil {
+			continue
+		}
+		if opts.VerifyETag && strings.Trim(etag, `"`) != expected {
+			err = fmt.Errorf("ETag %s of part %d doesn't match MD5 %s", etag, part.number, expected)
+			continue
+		}
+		return etag, nil
+	}
+	return "", fmt.Errorf("error uploading part %d: %s", part.number, err)
+}
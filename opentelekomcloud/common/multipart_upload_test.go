@@ -0,0 +1,143 @@
+package common
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"sync"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+type fakeUploader struct {
+	mu        sync.Mutex
+	uploadID  string
+	parts     map[int][]byte
+	uploads   map[int]int
+	failures  map[int]int
+	completed []byte
+}
+
+func newFakeUploader() *fakeUploader {
+	return &fakeUploader{
+		parts:    make(map[int][]byte),
+		uploads:  make(map[int]int),
+		failures: make(map[int]int),
+	}
+}
+
+func (u *fakeUploader) FindUpload() (string, error) {
+	return u.uploadID, nil
+}
+
+func (u *fakeUploader) InitiateUpload() (string, error) {
+	u.uploadID = "upload"
+	return u.uploadID, nil
+}
+
+func (u *fakeUploader) ListParts(string) (map[int]string, error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	parts := make(map[int]string)
+	for number, data := range u.parts {
+		sum := md5.Sum(data)
+		parts[number] = fmt.Sprintf(`"%s"`, hex.EncodeToString(sum[:]))
+	}
+	return parts, nil
+}
+
+func (u *fakeUploader) UploadPart(_ string, partNumber int, body io.ReadSeeker, size int64, contentMD5 string) (string, error) {
+	data, err := ioutil.ReadAll(body)
+	if err != nil {
+		return "", err
+	}
+	if int64(len(data)) != size {
+		return "", fmt.Errorf("expected %d bytes, got %d", size, len(data))
+	}
+	sum := md5.Sum(data)
+	if base64.StdEncoding.EncodeToString(sum[:]) != contentMD5 {
+		return "", fmt.Errorf("content MD5 mismatch")
+	}
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.uploads[partNumber]++
+	if u.failures[partNumber] > 0 {
+		u.failures[partNumber]--
+		return "", fmt.Errorf("transient error")
+	}
+	u.parts[partNumber] = data
+	return fmt.Sprintf(`"%s"`, hex.EncodeToString(sum[:])), nil
+}
+
+func (u *fakeUploader) CompleteUpload(_ string, parts []UploadedPart) (*UploadResult, error) {
+	var numbers []int
+	for _, part := range parts {
+		numbers = append(numbers, part.PartNumber)
+	}
+	sort.Ints(numbers)
+	var sums [][]byte
+	for _, number := range numbers {
+		u.completed = append(u.completed, u.parts[number]...)
+		sum := md5.Sum(u.parts[number])
+		sums = append(sums, sum[:])
+	}
+	return &UploadResult{ETag: MultipartETag(sums), VersionID: "version"}, nil
+}
+
+func testData(size int) []byte {
+	data := make([]byte, size)
+	for i := range data {
+		data[i] = byte(i % 251)
+	}
+	return data
+}
+
+func TestMultipartUpload(t *testing.T) {
+	data := testData(MinPartSize*2 + 100)
+	uploader := newFakeUploader()
+
+	opts := MultipartUploadOpts{PartSize: MinPartSize, ParallelParts: 2, VerifyETag: true}
+	result, err := MultipartUpload(uploader, bytes.NewReader(data), int64(len(data)), opts)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "version", result.VersionID)
+	th.AssertEquals(t, 3, len(uploader.parts))
+	th.AssertEquals(t, true, bytes.Equal(data, uploader.completed))
+}
+
+func TestMultipartUploadRetry(t *testing.T) {
+	data := testData(MinPartSize + 100)
+	uploader := newFakeUploader()
+	uploader.failures[2] = 1
+
+	opts := MultipartUploadOpts{PartSize: MinPartSize, Retries: 2, VerifyETag: true}
+	_, err := MultipartUpload(uploader, bytes.NewReader(data), int64(len(data)), opts)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, uploader.uploads[2])
+	th.AssertEquals(t, true, bytes.Equal(data, uploader.completed))
+}
+
+func TestMultipartUploadResume(t *testing.T) {
+	data := testData(MinPartSize*2 + 100)
+	uploader := newFakeUploader()
+	uploader.failures[3] = 2
+
+	opts := MultipartUploadOpts{PartSize: MinPartSize, ParallelParts: 1, Retries: 2, VerifyETag: true}
+	_, err := MultipartUpload(uploader, bytes.NewReader(data), int64(len(data)), opts)
+	if err == nil {
+		t.Fatal("expected upload to fail")
+	}
+
+	_, err = MultipartUpload(uploader, bytes.NewReader(data), int64(len(data)), opts)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, uploader.uploads[1])
+	th.AssertEquals(t, 1, uploader.uploads[2])
+	th.AssertEquals(t, 3, uploader.uploads[3])
+	th.AssertEquals(t, true, bytes.Equal(data, uploader.completed))
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/dns"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/ecs"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/elb"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/eps"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/evs"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/fw"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/iam"
@@ -33,7 +34,6 @@ import (
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/obs"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/rds"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/rts"
-	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/s3"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/sdrs"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/sfs"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/smn"
@@ -229,8 +229,10 @@ func Provider() terraform.ResourceProvider {
 			"opentelekomcloud_compute_bms_server_v2":         bms.DataSourceBMSServersV2(),
 			"opentelekomcloud_csbs_backup_v1":                csbs.DataSourceCSBSBackupV1(),
 			"opentelekomcloud_csbs_backup_policy_v1":         csbs.DataSourceCSBSBackupPolicyV1(),
+			"opentelekomcloud_css_flavors_v1":                css.DataSourceCssFlavorsV1(),
 			"opentelekomcloud_cts_tracker_v1":                cts.DataSourceCTSTrackerV1(),
 			"opentelekomcloud_dcs_az_v1":                     dcs.DataSourceDcsAZV1(),
+			"opentelekomcloud_dcs_flavors_v2":                dcs.DataSourceDcsFlavorsV2(),
 			"opentelekomcloud_dcs_maintainwindow_v1":         dcs.DataSourceDcsMaintainWindowV1(),
 			"opentelekomcloud_dcs_product_v1":                dcs.DataSourceDcsProductV1(),
 			"opentelekomcloud_deh_host_v1":                   deh.DataSourceDEHHostV1(),
@@ -241,19 +243,25 @@ func Provider() terraform.ResourceProvider {
 			"opentelekomcloud_dms_product_v1":                dms.DataSourceDmsProductV1(),
 			"opentelekomcloud_dms_maintainwindow_v1":         dms.DataSourceDmsMaintainWindowV1(),
 			"opentelekomcloud_dns_zone_v2":                   dns.DataSourceDNSZoneV2(),
+			"opentelekomcloud_enterprise_project":            eps.DataSourceEnterpriseProject(),
 			"opentelekomcloud_identity_auth_scope_v3":        iam.DataSourceIdentityAuthScopeV3(),
 			"opentelekomcloud_identity_credential_v3":        iam.DataSourceIdentityCredentialV3(),
 			"opentelekomcloud_identity_group_v3":             iam.DataSourceIdentityGroupV3(),
+			"opentelekomcloud_identity_policy_document_v3":   iam.DataSourceIdentityPolicyDocumentV3(),
 			"opentelekomcloud_identity_project_v3":           iam.DataSourceIdentityProjectV3(),
 			"opentelekomcloud_identity_role_v3":              iam.DataSourceIdentityRoleV3(),
 			"opentelekomcloud_identity_user_v3":              iam.DataSourceIdentityUserV3(),
+			"opentelekomcloud_identity_users_v3":             iam.DataSourceIdentityUsersV3(),
 			"opentelekomcloud_images_image_v2":               ims.DataSourceImagesImageV2(),
 			"opentelekomcloud_kms_key_v1":                    kms.DataSourceKmsKeyV1(),
 			"opentelekomcloud_kms_data_key_v1":               kms.DataSourceKmsDataKeyV1(),
+			"opentelekomcloud_kms_secrets_v1":                kms.DataSourceKmsSecretsV1(),
 			"opentelekomcloud_networking_network_v2":         vpc.DataSourceNetworkingNetworkV2(),
 			"opentelekomcloud_networking_port_v2":            vpc.DataSourceNetworkingPortV2(),
 			"opentelekomcloud_networking_secgroup_v2":        vpc.DataSourceNetworkingSecGroupV2(),
 			"opentelekomcloud_obs_bucket_object":             obs.DataSourceObsBucketObject(),
+			"opentelekomcloud_obs_buckets":                   obs.DataSourceObsBuckets(),
+			"opentelekomcloud_rds_backups_v3":                rds.DataSourceRdsBackupsV3(),
 			"opentelekomcloud_rds_flavors_v1":                rds.DataSourceRdsFlavorV1(),
 			"opentelekomcloud_rds_flavors_v3":                rds.DataSourceRdsFlavorV3(),
 			"opentelekomcloud_rds_versions_v3":               rds.DataSourceRdsVersionsV3(),
@@ -261,7 +269,7 @@ func Provider() terraform.ResourceProvider {
 			"opentelekomcloud_rts_software_config_v1":        rts.DataSourceRtsSoftwareConfigV1(),
 			"opentelekomcloud_rts_stack_resource_v1":         rts.DataSourceRTSStackResourcesV1(),
 			"opentelekomcloud_rts_stack_v1":                  rts.DataSourceRTSStackV1(),
-			"opentelekomcloud_s3_bucket_object":              s3.DataSourceS3BucketObject(),
+			"opentelekomcloud_s3_bucket_object":              obs.DataSourceS3BucketObject(),
 			"opentelekomcloud_sfs_file_system_v2":            sfs.DataSourceSFSFileSystemV2(),
 			"opentelekomcloud_sdrs_domain_v1":                sdrs.DataSourceSdrsDomainV1(),
 			"opentelekomcloud_vpc_v1":                        vpc.DataSourceVirtualPrivateCloudVpcV1(),
@@ -302,36 +310,54 @@ func Provider() terraform.ResourceProvider {
 			"opentelekomcloud_csbs_backup_policy_v1":              csbs.ResourceCSBSBackupPolicyV1(),
 			"opentelekomcloud_cts_tracker_v1":                     cts.ResourceCTSTrackerV1(),
 			"opentelekomcloud_css_cluster_v1":                     css.ResourceCssClusterV1(),
+			"opentelekomcloud_css_snapshot_v1":                    css.ResourceCssSnapshotV1(),
 			"opentelekomcloud_dcs_instance_v1":                    dcs.ResourceDcsInstanceV1(),
+			"opentelekomcloud_dcs_instance_v2":                    dcs.ResourceDcsInstanceV2(),
 			"opentelekomcloud_dds_instance_v3":                    dds.ResourceDdsInstanceV3(),
+			"opentelekomcloud_dds_read_replica_v3":                dds.ResourceDdsReadReplicaV3(),
 			"opentelekomcloud_deh_host_v1":                        deh.ResourceDeHHostV1(),
 			"opentelekomcloud_dns_ptrrecord_v2":                   dns.ResourceDNSPtrRecordV2(),
 			"opentelekomcloud_dns_recordset_v2":                   dns.ResourceDNSRecordSetV2(),
 			"opentelekomcloud_dns_zone_v2":                        dns.ResourceDNSZoneV2(),
 			"opentelekomcloud_dms_group_v1":                       dms.ResourceDmsGroupsV1(),
 			"opentelekomcloud_dms_instance_v1":                    dms.ResourceDmsInstancesV1(),
+			"opentelekomcloud_dms_kafka_instance_v2":              dms.ResourceDmsKafkaInstanceV2(),
+			"opentelekomcloud_dms_kafka_topic_v2":                 dms.ResourceDmsKafkaTopicV2(),
+			"opentelekomcloud_dms_kafka_user_v2":                  dms.ResourceDmsKafkaUserV2(),
 			"opentelekomcloud_dms_queue_v1":                       dms.ResourceDmsQueuesV1(),
 			"opentelekomcloud_ecs_instance_v1":                    ecs.ResourceEcsInstanceV1(),
 			"opentelekomcloud_elb_backend":                        elb.ResourceBackend(),
 			"opentelekomcloud_elb_health":                         elb.ResourceHealth(),
 			"opentelekomcloud_elb_loadbalancer":                   elb.ResourceELoadBalancer(),
 			"opentelekomcloud_elb_listener":                       elb.ResourceEListener(),
+			"opentelekomcloud_enterprise_project":                 eps.ResourceEnterpriseProject(),
 			"opentelekomcloud_evs_volume_v3":                      evs.ResourceEvsStorageVolumeV3(),
 			"opentelekomcloud_fw_firewall_group_v2":               fw.ResourceFWFirewallGroupV2(),
 			"opentelekomcloud_fw_policy_v2":                       fw.ResourceFWPolicyV2(),
 			"opentelekomcloud_fw_rule_v2":                         fw.ResourceFWRuleV2(),
+			"opentelekomcloud_identity_acl_v3":                    iam.ResourceIdentityACLV3(),
 			"opentelekomcloud_identity_agency_v3":                 iam.ResourceIdentityAgencyV3(),
+			"opentelekomcloud_identity_agency_role_assignment_v3": iam.ResourceIdentityAgencyRoleAssignmentV3(),
 			"opentelekomcloud_identity_credential_v3":             iam.ResourceIdentityCredentialV3(),
 			"opentelekomcloud_identity_group_v3":                  iam.ResourceIdentityGroupV3(),
 			"opentelekomcloud_identity_group_membership_v3":       iam.ResourceIdentityGroupMembershipV3(),
+			"opentelekomcloud_identity_login_policy_v3":           iam.ResourceIdentityLoginPolicyV3(),
+			"opentelekomcloud_identity_login_protection_v3":       iam.ResourceIdentityLoginProtectionV3(),
+			"opentelekomcloud_identity_mapping_v3":                iam.ResourceIdentityMappingV3(),
+			"opentelekomcloud_identity_password_policy_v3":        iam.ResourceIdentityPasswordPolicyV3(),
 			"opentelekomcloud_identity_project_v3":                iam.ResourceIdentityProjectV3(),
+			"opentelekomcloud_identity_protocol_v3":               iam.ResourceIdentityProtocolV3(),
+			"opentelekomcloud_identity_provider_v3":               iam.ResourceIdentityProviderV3(),
 			"opentelekomcloud_identity_role_v3":                   iam.ResourceIdentityRoleV3(),
 			"opentelekomcloud_identity_role_assignment_v3":        iam.ResourceIdentityRoleAssignmentV3(),
 			"opentelekomcloud_identity_user_v3":                   iam.ResourceIdentityUserV3(),
 			"opentelekomcloud_images_image_v2":                    ims.ResourceImagesImageV2(),
 			"opentelekomcloud_ims_data_image_v2":                  ims.ResourceImsDataImageV2(),
 			"opentelekomcloud_ims_image_v2":                       ims.ResourceImsImageV2(),
+			"opentelekomcloud_kms_ciphertext_v1":                  kms.ResourceKmsCiphertextV1(),
+			"opentelekomcloud_kms_grant_v1":                       kms.ResourceKmsGrantV1(),
 			"opentelekomcloud_kms_key_v1":                         kms.ResourceKmsKeyV1(),
+			"opentelekomcloud_kms_key_material_v1":                kms.ResourceKmsKeyMaterialV1(),
 			"opentelekomcloud_lb_certificate_v2":                  elb.ResourceCertificateV2(),
 			"opentelekomcloud_lb_l7policy_v2":                     elb.ResourceL7PolicyV2(),
 			"opentelekomcloud_lb_l7rule_v2":                       elb.ResourceL7RuleV2(),
@@ -357,21 +383,27 @@ func Provider() terraform.ResourceProvider {
 			"opentelekomcloud_networking_router_route_v2":         vpc.ResourceNetworkingRouterRouteV2(),
 			"opentelekomcloud_networking_secgroup_v2":             vpc.ResourceNetworkingSecGroupV2(),
 			"opentelekomcloud_networking_secgroup_rule_v2":        vpc.ResourceNetworkingSecGroupRuleV2(),
+			"opentelekomcloud_networking_secgroup_rules_v2":       vpc.ResourceNetworkingSecGroupRulesV2(),
 			"opentelekomcloud_networking_subnet_v2":               vpc.ResourceNetworkingSubnetV2(),
 			"opentelekomcloud_networking_vip_v2":                  vpc.ResourceNetworkingVIPV2(),
 			"opentelekomcloud_networking_vip_associate_v2":        vpc.ResourceNetworkingVIPAssociateV2(),
 			"opentelekomcloud_obs_bucket":                         obs.ResourceObsBucket(),
 			"opentelekomcloud_obs_bucket_object":                  obs.ResourceObsBucketObject(),
 			"opentelekomcloud_obs_bucket_policy":                  obs.ResourceObsBucketPolicy(),
+			"opentelekomcloud_rds_backup_v3":                      rds.ResourceRdsBackupV3(),
+			"opentelekomcloud_rds_database_v3":                    rds.ResourceRdsDatabaseV3(),
+			"opentelekomcloud_rds_db_privilege_v3":                rds.ResourceRdsDbPrivilegeV3(),
+			"opentelekomcloud_rds_db_user_v3":                     rds.ResourceRdsDbUserV3(),
 			"opentelekomcloud_rds_instance_v1":                    rds.ResourceRdsInstance(),
 			"opentelekomcloud_rds_instance_v3":                    rds.ResourceRdsInstanceV3(),
+			"opentelekomcloud_rds_parametergroup_apply_v3":        rds.ResourceRdsParameterGroupApplyV3(),
 			"opentelekomcloud_rds_parametergroup_v3":              rds.ResourceRdsConfigurationV3(),
 			"opentelekomcloud_rts_software_deployment_v1":         rts.ResourceRtsSoftwareDeploymentV1(),
 			"opentelekomcloud_rts_software_config_v1":             rts.ResourceSoftwareConfigV1(),
 			"opentelekomcloud_rts_stack_v1":                       rts.ResourceRTSStackV1(),
-			"opentelekomcloud_s3_bucket":                          s3.ResourceS3Bucket(),
-			"opentelekomcloud_s3_bucket_policy":                   s3.ResourceS3BucketPolicy(),
-			"opentelekomcloud_s3_bucket_object":                   s3.ResourceS3BucketObject(),
+			"opentelekomcloud_s3_bucket":                          obs.ResourceS3Bucket(),
+			"opentelekomcloud_s3_bucket_policy":                   obs.ResourceS3BucketPolicy(),
+			"opentelekomcloud_s3_bucket_object":                   obs.ResourceS3BucketObject(),
 			"opentelekomcloud_sfs_file_system_v2":                 sfs.ResourceSFSFileSystemV2(),
 			"opentelekomcloud_sfs_turbo_share_v1":                 sfs.ResourceSFSTurboShareV1(),
 			"opentelekomcloud_smn_topic_v2":                       smn.ResourceTopic(),
@@ -380,6 +412,8 @@ func Provider() terraform.ResourceProvider {
 			"opentelekomcloud_vpc_v1":                             vpc.ResourceVirtualPrivateCloudV1(),
 			"opentelekomcloud_vpc_peering_connection_v2":          vpc.ResourceVpcPeeringConnectionV2(),
 			"opentelekomcloud_vpc_peering_connection_accepter_v2": vpc.ResourceVpcPeeringConnectionAccepterV2(),
+			"opentelekomcloud_vpc_address_group_v3":               vpc.ResourceVpcAddressGroupV3(),
+			"opentelekomcloud_vpc_route_table_v1":                 vpc.ResourceVPCRouteTableV1(),
 			"opentelekomcloud_vpc_route_v2":                       vpc.ResourceVPCRouteV2(),
 			"opentelekomcloud_vpc_subnet_v1":                      vpc.ResourceVpcSubnetV1(),
 			"opentelekomcloud_vpc_flow_log_v1":                    vpc.ResourceVpcFlowLogV1(),
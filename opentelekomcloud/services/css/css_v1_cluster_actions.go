@@ -0,0 +1,195 @@
+package css
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// ClusterV1 contains the cluster fields required to follow the cluster actions.
+type ClusterV1 struct {
+	ID              string                 `json:"id"`
+	Status          string                 `json:"status"`
+	HttpsEnable     bool                   `json:"httpsEnable"`
+	AuthorityEnable bool                   `json:"authorityEnable"`
+	Actions         []string               `json:"actions"`
+	ActionProgress  map[string]interface{} `json:"actionProgress"`
+	Instances       []ClusterV1Instance    `json:"instances"`
+}
+
+// ClusterV1Instance represents a node of the cluster.
+type ClusterV1Instance struct {
+	ID       string `json:"id"`
+	Status   string `json:"status"`
+	SpecCode string `json:"specCode"`
+	Volume   struct {
+		Type string `json:"type"`
+		Size int    `json:"size"`
+	} `json:"volume"`
+}
+
+// ChangeModeOpts contains the values needed to change the cluster security mode.
+type ChangeModeOpts struct {
+	AuthorityEnable bool   `json:"authorityEnable"`
+	AdminPwd        string `json:"adminPwd,omitempty"`
+	HttpsEnable     bool   `json:"httpsEnable"`
+}
+
+// SnapshotSettingOpts contains the basic configuration of the cluster snapshots.
+type SnapshotSettingOpts struct {
+	Bucket   string `json:"bucket" required:"true"`
+	Agency   string `json:"agency" required:"true"`
+	BasePath string `json:"basePath" required:"true"`
+}
+
+// FlavorV1 represents CSS node flavor.
+type FlavorV1 struct {
+	ID        string `json:"flavor_id"`
+	Name      string `json:"name"`
+	CPU       int    `json:"cpu"`
+	RAM       int    `json:"ram"`
+	Region    string `json:"region"`
+	DiskRange string `json:"diskrange"`
+}
+
+// FlavorV1Version represents CSS flavors available for the engine version.
+type FlavorV1Version struct {
+	Version string     `json:"version"`
+	Type    string     `json:"type"`
+	Flavors []FlavorV1 `json:"flavors"`
+}
+
+// cssRequestOpts returns new request options for each call as the client modifies them
+func cssRequestOpts(codes ...int) *golangsdk.RequestOpts {
+	if len(codes) == 0 {
+		codes = []int{200}
+	}
+	return &golangsdk.RequestOpts{
+		OkCodes:     codes,
+		MoreHeaders: map[string]string{"Content-Type": "application/json", "X-Language": "en-us"},
+	}
+}
+
+func getCluster(client *golangsdk.ServiceClient, id string) (*ClusterV1, error) {
+	var res ClusterV1
+	_, err := client.Get(client.ServiceURL("clusters", id), &res, cssRequestOpts(200))
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func changeClusterFlavor(client *golangsdk.ServiceClient, id, flavorID string) error {
+	b := map[string]interface{}{
+		"needCheckReplica": true,
+		"newFlavorId":      flavorID,
+	}
+	_, err := client.Post(client.ServiceURL("clusters", id, "flavor"), b, nil, cssRequestOpts(200))
+	return err
+}
+
+// extendClusterVolume increases volume size of each node by `delta` GB
+func extendClusterVolume(client *golangsdk.ServiceClient, id string, delta int) error {
+	b := map[string]interface{}{
+		"grow": []map[string]interface{}{
+			{
+				"type":     "ess",
+				"nodesize": 0,
+				"disksize": delta,
+			},
+		},
+	}
+	_, err := client.Post(client.ServiceURL("clusters", id, "role_extend"), b, nil, cssRequestOpts(200))
+	return err
+}
+
+func changeClusterMode(client *golangsdk.ServiceClient, id string, opts ChangeModeOpts) error {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return err
+	}
+	_, err = client.Post(client.ServiceURL("clusters", id, "mode", "change"), b, nil, cssRequestOpts(200))
+	return err
+}
+
+func resetClusterPassword(client *golangsdk.ServiceClient, id, password string) error {
+	b := map[string]string{
+		"newpassword": password,
+	}
+	_, err := client.Post(client.ServiceURL("clusters", id, "password", "reset"), b, nil, cssRequestOpts(200))
+	return err
+}
+
+func updateSnapshotSetting(client *golangsdk.ServiceClient, id string, opts SnapshotSettingOpts) error {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return err
+	}
+	_, err = client.Post(client.ServiceURL("clusters", id, "index_snapshot", "setting"), b, nil, cssRequestOpts(200))
+	return err
+}
+
+func listFlavors(client *golangsdk.ServiceClient) ([]FlavorV1Version, error) {
+	var res struct {
+		Versions []FlavorV1Version `json:"versions"`
+	}
+	_, err := client.Get(client.ServiceURL("es-flavors"), &res, cssRequestOpts(200))
+	if err != nil {
+		return nil, err
+	}
+	return res.Versions, nil
+}
+
+// getFlavorID returns ID of the flavor by its name
+func getFlavorID(client *golangsdk.ServiceClient, name string) (string, error) {
+	versions, err := listFlavors(client)
+	if err != nil {
+		return "", err
+	}
+	for _, version := range versions {
+		for _, flavor := range version.Flavors {
+			if flavor.Name == name {
+				return flavor.ID, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("CSS flavor %s not found", name)
+}
+
+// waitForClusterReady waits for the cluster and all its nodes to become available
+// and for all running actions to finish
+func waitForClusterReady(client *golangsdk.ServiceClient, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Pending"},
+		Target:  []string{"Done"},
+		Refresh: func() (interface{}, string, error) {
+			cluster, err := getCluster(client, id)
+			if err != nil {
+				return nil, "", err
+			}
+			if cluster.Status != "200" || len(cluster.Actions) != 0 || len(cluster.ActionProgress) != 0 {
+				return cluster, "Pending", nil
+			}
+			for _, instance := range cluster.Instances {
+				if instance.Status != "200" {
+					return cluster, "Pending", nil
+				}
+			}
+			return cluster, "Done", nil
+		},
+		Timeout:    timeout,
+		Delay:      30 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for CSS cluster %s to become ready: %s", id, err)
+	}
+	return nil
+}
+
+func disableSnapshots(client *golangsdk.ServiceClient, id string) error {
+	_, err := client.Delete(client.ServiceURL("clusters", id, "index_snapshots"), cssRequestOpts(200))
+	return err
+}
@@ -0,0 +1,174 @@
+package css
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func DataSourceCssFlavorsV1() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCssFlavorsV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "ess",
+			},
+			"min_cpu": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"min_ram": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"disk_size": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"flavors": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cpu": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"ram": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"disk_min": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"disk_max": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// parseDiskRange parses disk range in `<min>,<max>` format
+func parseDiskRange(diskRange string) (int, int, error) {
+	parts := strings.Split(diskRange, ",")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("invalid disk range format: %s", diskRange)
+	}
+	min, err := strconv.Atoi(strings.TrimSpace(parts[0]))
+	if err != nil {
+		return 0, 0, err
+	}
+	max, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+	if err != nil {
+		return 0, 0, err
+	}
+	return min, max, nil
+}
+
+func dataSourceCssFlavorsV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.CssV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud CSSv1 client: %s", err)
+	}
+
+	versions, err := listFlavors(client)
+	if err != nil {
+		return fmt.Errorf("unable to list CSS flavors: %s", err)
+	}
+
+	name := d.Get("name").(string)
+	engineVersion := d.Get("version").(string)
+	nodeType := d.Get("type").(string)
+	minCPU := d.Get("min_cpu").(int)
+	minRAM := d.Get("min_ram").(int)
+	diskSize := d.Get("disk_size").(int)
+
+	result := make([]map[string]interface{}, 0)
+	for _, version := range versions {
+		if engineVersion != "" && version.Version != engineVersion {
+			continue
+		}
+		if nodeType != "" && version.Type != nodeType {
+			continue
+		}
+		for _, flavor := range version.Flavors {
+			if name != "" && flavor.Name != name {
+				continue
+			}
+			if flavor.CPU < minCPU || flavor.RAM < minRAM {
+				continue
+			}
+			diskMin, diskMax, err := parseDiskRange(flavor.DiskRange)
+			if err != nil {
+				return fmt.Errorf("error parsing CSS flavor %s disk range: %s", flavor.Name, err)
+			}
+			if diskSize != 0 && (diskSize < diskMin || diskSize > diskMax) {
+				continue
+			}
+			result = append(result, map[string]interface{}{
+				"id":       flavor.ID,
+				"name":     flavor.Name,
+				"version":  version.Version,
+				"type":     version.Type,
+				"cpu":      flavor.CPU,
+				"ram":      flavor.RAM,
+				"disk_min": diskMin,
+				"disk_max": diskMax,
+			})
+		}
+	}
+
+	if len(result) < 1 {
+		return fmt.Errorf("your query returned no results. Please change your search criteria and try again")
+	}
+
+	d.SetId("flavors")
+	mErr := multierror.Append(nil,
+		d.Set("flavors", result),
+		d.Set("region", config.GetRegion(d)),
+	)
+	return mErr.ErrorOrNil()
+}
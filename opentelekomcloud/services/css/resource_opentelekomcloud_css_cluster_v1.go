@@ -6,8 +6,11 @@ import (
 	"reflect"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/css/v1/snapshots"
 
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
@@ -22,9 +25,14 @@ func ResourceCssClusterV1() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(15 * time.Minute),
-			Update: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
 		},
 
+		CustomizeDiff: customdiff.All(
+			checkCssClusterV1VolumeSize,
+			checkCssClusterV1AdminPass,
+		),
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -35,14 +43,12 @@ func ResourceCssClusterV1() *schema.Resource {
 			"node_config": {
 				Type:     schema.TypeList,
 				Required: true,
-				ForceNew: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"flavor": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 						},
 						"network_info": {
 							Type:     schema.TypeList,
@@ -72,14 +78,12 @@ func ResourceCssClusterV1() *schema.Resource {
 						"volume": {
 							Type:     schema.TypeList,
 							Required: true,
-							ForceNew: true,
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"size": {
 										Type:     schema.TypeInt,
 										Required: true,
-										ForceNew: true,
 									},
 									"volume_type": {
 										Type:     schema.TypeString,
@@ -108,7 +112,56 @@ func ResourceCssClusterV1() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 				Optional: true,
-				ForceNew: true,
+			},
+
+			"enable_authority": {
+				Type:     schema.TypeBool,
+				Computed: true,
+				Optional: true,
+			},
+
+			"admin_pass": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+
+			"backup_strategy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"agency": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"base_path": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "css_repository",
+						},
+						"prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "snapshot",
+						},
+						"period": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"keep_days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      7,
+							ValidateFunc: validation.IntBetween(1, 90),
+						},
+					},
+				},
 			},
 
 			"expect_node_num": {
@@ -178,6 +231,8 @@ func resourceCssClusterV1UserInputParams(d *schema.ResourceData) map[string]inte
 	return map[string]interface{}{
 		"terraform_resource_data": d,
 		"enable_https":            d.Get("enable_https"),
+		"enable_authority":        d.Get("enable_authority"),
+		"admin_pass":              d.Get("admin_pass"),
 		"expect_node_num":         d.Get("expect_node_num"),
 		"name":                    d.Get("name"),
 		"node_config":             d.Get("node_config"),
@@ -219,6 +274,12 @@ func resourceCssClusterV1Create(d *schema.ResourceData, meta interface{}) error
 	}
 	d.SetId(id.(string))
 
+	if _, ok := d.GetOk("backup_strategy"); ok {
+		if err := updateCssClusterV1BackupStrategy(d, client); err != nil {
+			return err
+		}
+	}
+
 	return resourceCssClusterV1Read(d, meta)
 }
 
@@ -237,7 +298,37 @@ func resourceCssClusterV1Read(d *schema.ResourceData, meta interface{}) error {
 	}
 	res["read"] = v
 
-	return setCssClusterV1Properties(d, res)
+	if err := setCssClusterV1Properties(d, res); err != nil {
+		return err
+	}
+
+	policy, err := snapshots.PolicyGet(client, d.Id()).Extract()
+	if err != nil {
+		switch err.(type) {
+		case golangsdk.ErrDefault403, golangsdk.ErrDefault404:
+			// snapshot policy is not available for the cluster, don't fail the whole read
+			log.Printf("[WARN] Unable to read CSS cluster %s snapshot policy: %s", d.Id(), err)
+			return nil
+		default:
+			return fmt.Errorf("error reading CSS cluster snapshot policy: %s", err)
+		}
+	}
+	var backupStrategy []map[string]interface{}
+	if policy.Enable == "true" {
+		backupStrategy = append(backupStrategy, map[string]interface{}{
+			"bucket":    policy.Bucket,
+			"agency":    policy.Agency,
+			"base_path": policy.BasePath,
+			"prefix":    policy.Prefix,
+			"period":    policy.Period,
+			"keep_days": policy.KeepDay,
+		})
+	}
+	if err := d.Set("backup_strategy", backupStrategy); err != nil {
+		return fmt.Errorf("error setting Cluster:backup_strategy, err: %s", err)
+	}
+
+	return nil
 }
 
 func resourceCssClusterV1Update(d *schema.ResourceData, meta interface{}) error {
@@ -271,6 +362,55 @@ func resourceCssClusterV1Update(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	if d.HasChange("node_config.0.flavor") {
+		flavorID, err := getFlavorID(client, d.Get("node_config.0.flavor").(string))
+		if err != nil {
+			return err
+		}
+		if err := changeClusterFlavor(client, d.Id(), flavorID); err != nil {
+			return fmt.Errorf("error changing CSS cluster flavor: %s", err)
+		}
+		if err := waitForClusterReady(client, d.Id(), timeout); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("node_config.0.volume.0.size") {
+		oldSize, newSize := d.GetChange("node_config.0.volume.0.size")
+		if err := extendClusterVolume(client, d.Id(), newSize.(int)-oldSize.(int)); err != nil {
+			return fmt.Errorf("error extending CSS cluster volume: %s", err)
+		}
+		if err := waitForClusterReady(client, d.Id(), timeout); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChanges("enable_https", "enable_authority") {
+		modeOpts := ChangeModeOpts{
+			AuthorityEnable: d.Get("enable_authority").(bool),
+			HttpsEnable:     d.Get("enable_https").(bool),
+		}
+		if modeOpts.AuthorityEnable {
+			modeOpts.AdminPwd = d.Get("admin_pass").(string)
+		}
+		if err := changeClusterMode(client, d.Id(), modeOpts); err != nil {
+			return fmt.Errorf("error changing CSS cluster security mode: %s", err)
+		}
+		if err := waitForClusterReady(client, d.Id(), timeout); err != nil {
+			return err
+		}
+	} else if d.HasChange("admin_pass") && d.Get("enable_authority").(bool) {
+		if err := resetClusterPassword(client, d.Id(), d.Get("admin_pass").(string)); err != nil {
+			return fmt.Errorf("error resetting CSS cluster admin password: %s", err)
+		}
+	}
+
+	if d.HasChange("backup_strategy") {
+		if err := updateCssClusterV1BackupStrategy(d, client); err != nil {
+			return err
+		}
+	}
+
 	return resourceCssClusterV1Read(d, meta)
 }
 
@@ -318,6 +458,61 @@ func resourceCssClusterV1Delete(d *schema.ResourceData, meta interface{}) error
 	return err
 }
 
+// checkCssClusterV1VolumeSize recreates the cluster if the volume size is reduced,
+// the volume can only be extended in place
+func checkCssClusterV1VolumeSize(d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.HasChange("node_config.0.volume.0.size") {
+		return nil
+	}
+	oldSize, newSize := d.GetChange("node_config.0.volume.0.size")
+	if newSize.(int) < oldSize.(int) {
+		return d.ForceNew("node_config.0.volume.0.size")
+	}
+	return nil
+}
+
+func checkCssClusterV1AdminPass(d *schema.ResourceDiff, _ interface{}) error {
+	if d.Get("admin_pass").(string) == "" || !d.NewValueKnown("enable_authority") {
+		return nil
+	}
+	if !d.Get("enable_authority").(bool) {
+		return fmt.Errorf("`admin_pass` can be set only with `enable_authority = true`")
+	}
+	return nil
+}
+
+func updateCssClusterV1BackupStrategy(d *schema.ResourceData, client *golangsdk.ServiceClient) error {
+	raw := d.Get("backup_strategy").([]interface{})
+	if len(raw) == 0 {
+		log.Printf("[DEBUG] Disabling snapshots of CSS cluster %s", d.Id())
+		if err := disableSnapshots(client, d.Id()); err != nil {
+			return fmt.Errorf("error disabling CSS cluster snapshots: %s", err)
+		}
+		return nil
+	}
+	strategy := raw[0].(map[string]interface{})
+
+	settingOpts := SnapshotSettingOpts{
+		Bucket:   strategy["bucket"].(string),
+		Agency:   strategy["agency"].(string),
+		BasePath: strategy["base_path"].(string),
+	}
+	if err := updateSnapshotSetting(client, d.Id(), settingOpts); err != nil {
+		return fmt.Errorf("error setting CSS cluster snapshot configuration: %s", err)
+	}
+
+	policyOpts := snapshots.PolicyCreateOpts{
+		Prefix:  strategy["prefix"].(string),
+		Period:  strategy["period"].(string),
+		KeepDay: strategy["keep_days"].(int),
+		Enable:  "true",
+	}
+	if err := snapshots.PolicyCreate(client, policyOpts, d.Id()).ExtractErr(); err != nil {
+		return fmt.Errorf("error setting CSS cluster snapshot policy: %s", err)
+	}
+	return nil
+}
+
 func buildCssClusterV1CreateParameters(opts map[string]interface{}, arrayIndex map[string]int) (interface{}, error) {
 	params := make(map[string]interface{})
 
@@ -351,6 +546,11 @@ func buildCssClusterV1CreateParameters(opts map[string]interface{}, arrayIndex m
 		params["instance"] = v
 	}
 
+	if v, ok := opts["enable_authority"].(bool); ok && v {
+		params["authorityEnable"] = true
+		params["adminPwd"] = opts["admin_pass"]
+	}
+
 	v, err = common.NavigateValue(opts, []string{"expect_node_num"}, arrayIndex)
 	if err != nil {
 		return nil, err
@@ -703,6 +903,14 @@ func setCssClusterV1Properties(d *schema.ResourceData, response map[string]inter
 		return fmt.Errorf("error setting Cluster:enable_https, err: %s", err)
 	}
 
+	v, err = common.NavigateValue(response, []string{"read", "authorityEnable"}, nil)
+	if err != nil {
+		v = false
+	}
+	if err = d.Set("enable_authority", v); err != nil {
+		return fmt.Errorf("error setting Cluster:enable_authority, err: %s", err)
+	}
+
 	v, err = common.NavigateValue(response, []string{"read", "endpoint"}, nil)
 	if err != nil {
 		return fmt.Errorf("error reading Cluster:endpoint, err: %s", err)
@@ -783,6 +991,11 @@ func flattenCssClusterV1NodeConfig(d interface{}, arrayIndex map[string]int, cur
 	}
 	r := result[0].(map[string]interface{})
 
+	firstInstance := map[string]int{"read.instances": 0}
+	if v, err := common.NavigateValue(d, []string{"read", "instances", "specCode"}, firstInstance); err == nil {
+		r["flavor"] = v
+	}
+
 	v, _ := r["network_info"]
 	v, err := flattenCssClusterV1NodeConfigNetworkInfo(d, arrayIndex, v)
 	if err != nil {
@@ -841,6 +1054,11 @@ func flattenCssClusterV1NodeConfigVolume(d interface{}, arrayIndex map[string]in
 	}
 	r["encryption_key"] = v
 
+	firstInstance := map[string]int{"read.instances": 0}
+	if v, err := common.NavigateValue(d, []string{"read", "instances", "volume", "size"}, firstInstance); err == nil {
+		r["size"] = v
+	}
+
 	return result, nil
 }
 
@@ -0,0 +1,221 @@
+package css
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/css/v1/snapshots"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceCssSnapshotV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCssSnapshotV1Create,
+		Read:   resourceCssSnapshotV1Read,
+		Delete: resourceCssSnapshotV1Delete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceCssSnapshotV1Import,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"indices": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"cluster_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"backup_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bucket": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// GetCssSnapshot returns the snapshot of the cluster by its ID, `nil` is returned if the snapshot doesn't exist
+func GetCssSnapshot(client *golangsdk.ServiceClient, clusterID, id string) (*snapshots.Snapshot, error) {
+	snapshotList, err := snapshots.List(client, clusterID).Extract()
+	if err != nil {
+		return nil, err
+	}
+	for _, snapshot := range snapshotList {
+		if snapshot.ID == id {
+			return &snapshot, nil
+		}
+	}
+	return nil, nil
+}
+
+func resourceCssSnapshotV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.CssV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud CSSv1 client: %s", err)
+	}
+
+	clusterID := d.Get("cluster_id").(string)
+	createOpts := snapshots.CreateOpts{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Indices:     d.Get("indices").(string),
+	}
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	snapshot, err := snapshots.Create(client, createOpts, clusterID).Extract()
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud CSS snapshot: %s", err)
+	}
+	d.SetId(snapshot.ID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"IN_PROGRESS"},
+		Target:     []string{"COMPLETED"},
+		Refresh:    cssSnapshotV1StateRefreshFunc(client, clusterID, snapshot.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for CSS snapshot (%s) to complete: %s", snapshot.ID, err)
+	}
+
+	return resourceCssSnapshotV1Read(d, meta)
+}
+
+func resourceCssSnapshotV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.CssV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud CSSv1 client: %s", err)
+	}
+
+	snapshot, err := GetCssSnapshot(client, d.Get("cluster_id").(string), d.Id())
+	if err != nil {
+		return common.CheckDeleted(d, err, "error fetching CSS snapshot")
+	}
+	if snapshot == nil {
+		log.Printf("[WARN] CSS snapshot %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", snapshot.Name),
+		d.Set("description", snapshot.Description),
+		d.Set("indices", snapshot.Indices),
+		d.Set("cluster_name", snapshot.ClusterName),
+		d.Set("backup_type", snapshot.Type),
+		d.Set("bucket", snapshot.Bucket),
+		d.Set("status", snapshot.Status),
+		d.Set("created", snapshot.Created),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmt.Errorf("error setting CSS snapshot fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceCssSnapshotV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.CssV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud CSSv1 client: %s", err)
+	}
+
+	clusterID := d.Get("cluster_id").(string)
+	if err := snapshots.Delete(client, clusterID, d.Id()).ExtractErr(); err != nil {
+		return common.CheckDeleted(d, err, "error deleting OpenTelekomCloud CSS snapshot")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"COMPLETED", "DELETING"},
+		Target:     []string{"DELETED"},
+		Refresh:    cssSnapshotV1StateRefreshFunc(client, clusterID, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for CSS snapshot (%s) to delete: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func cssSnapshotV1StateRefreshFunc(client *golangsdk.ServiceClient, clusterID, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		snapshot, err := GetCssSnapshot(client, clusterID, id)
+		if err != nil {
+			return nil, "", err
+		}
+		if snapshot == nil {
+			return id, "DELETED", nil
+		}
+		return snapshot, snapshot.Status, nil
+	}
+}
+
+func resourceCssSnapshotV1Import(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("invalid format specified for CSS snapshot, must be <cluster_id>/<snapshot_id>")
+	}
+	d.SetId(parts[1])
+	if err := d.Set("cluster_id", parts[0]); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,160 @@
+package dcs
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func DataSourceDcsFlavorsV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDcsFlavorsV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"capacity": {
+				Type:     schema.TypeFloat,
+				Optional: true,
+			},
+			"engine": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "Redis",
+			},
+			"engine_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"cache_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"single", "ha", "cluster", "proxy", "ha_rw_split",
+				}, false),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"cpu_architecture": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"x86_64", "aarch64",
+				}, false),
+			},
+			"flavors": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cache_mode": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"engine": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"engine_versions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"cpu_architecture": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"capacity": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"available_zones": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"ip_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDcsFlavorsV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DcsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DCSv2 client: %s", err)
+	}
+
+	listOpts := ListFlavorsV2Opts{
+		SpecCode:      d.Get("name").(string),
+		CacheMode:     d.Get("cache_mode").(string),
+		Engine:        d.Get("engine").(string),
+		EngineVersion: d.Get("engine_version").(string),
+		CPUType:       d.Get("cpu_architecture").(string),
+	}
+	if v, ok := d.GetOk("capacity"); ok {
+		listOpts.Capacity = strconv.FormatFloat(v.(float64), 'f', -1, 64)
+	}
+
+	flavorList, err := listFlavorsV2(client, listOpts)
+	if err != nil {
+		return fmt.Errorf("unable to list DCSv2 flavors: %s", err)
+	}
+
+	result := make([]map[string]interface{}, 0)
+	for _, flavor := range flavorList {
+		var capacity float64
+		if len(flavor.Capacity) > 0 {
+			capacity, err = strconv.ParseFloat(flavor.Capacity[0], 64)
+			if err != nil {
+				return fmt.Errorf("error parsing DCSv2 flavor capacity: %s", err)
+			}
+		}
+		var azCodes []string
+		for _, az := range flavor.AvailableZones {
+			azCodes = append(azCodes, az.AzCodes...)
+		}
+		result = append(result, map[string]interface{}{
+			"name":             flavor.SpecCode,
+			"cache_mode":       flavor.CacheMode,
+			"engine":           flavor.Engine,
+			"engine_versions":  strings.Split(flavor.EngineVersion, ";"),
+			"cpu_architecture": flavor.CPUType,
+			"capacity":         capacity,
+			"available_zones":  azCodes,
+			"ip_count":         flavor.TenantIPCount,
+		})
+	}
+
+	if len(result) < 1 {
+		return fmt.Errorf("your query returned no results. Please change your search criteria and try again")
+	}
+
+	d.SetId("flavors")
+	mErr := multierror.Append(nil,
+		d.Set("flavors", result),
+		d.Set("region", config.GetRegion(d)),
+	)
+	return mErr.ErrorOrNil()
+}
@@ -0,0 +1,224 @@
+package dcs
+
+import (
+	"fmt"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
+)
+
+// PeriodicalBackupPlanV2 represents DCSv2 backup plan.
+type PeriodicalBackupPlanV2 struct {
+	BeginAt    string `json:"begin_at" required:"true"`
+	PeriodType string `json:"period_type" required:"true"`
+	BackupAt   []int  `json:"backup_at" required:"true"`
+}
+
+// BackupPolicyV2 represents DCSv2 instance backup policy.
+type BackupPolicyV2 struct {
+	BackupType           string                 `json:"backup_type,omitempty"`
+	SaveDays             int                    `json:"save_days,omitempty"`
+	PeriodicalBackupPlan PeriodicalBackupPlanV2 `json:"periodical_backup_plan"`
+}
+
+// CreateInstanceV2Opts contains all the values needed to create DCSv2 instance.
+type CreateInstanceV2Opts struct {
+	Name             string             `json:"name" required:"true"`
+	Description      string             `json:"description,omitempty"`
+	Engine           string             `json:"engine" required:"true"`
+	EngineVersion    string             `json:"engine_version" required:"true"`
+	Capacity         float64            `json:"capacity" required:"true"`
+	SpecCode         string             `json:"spec_code" required:"true"`
+	AzCodes          []string           `json:"az_codes" required:"true"`
+	Port             int                `json:"port,omitempty"`
+	VpcID            string             `json:"vpc_id" required:"true"`
+	SubnetID         string             `json:"subnet_id" required:"true"`
+	SecurityGroupID  string             `json:"security_group_id,omitempty"`
+	PrivateIP        string             `json:"private_ip,omitempty"`
+	Password         string             `json:"password,omitempty"`
+	NoPasswordAccess bool               `json:"no_password_access"`
+	MaintainBegin    string             `json:"maintain_begin,omitempty"`
+	MaintainEnd      string             `json:"maintain_end,omitempty"`
+	BackupPolicy     *BackupPolicyV2    `json:"instance_backup_policy,omitempty"`
+	Tags             []tags.ResourceTag `json:"tags,omitempty"`
+}
+
+// UpdateInstanceV2Opts contains the values of DCSv2 instance which can be updated.
+type UpdateInstanceV2Opts struct {
+	Name            string          `json:"name,omitempty"`
+	Description     *string         `json:"description,omitempty"`
+	MaintainBegin   string          `json:"maintain_begin,omitempty"`
+	MaintainEnd     string          `json:"maintain_end,omitempty"`
+	SecurityGroupID string          `json:"security_group_id,omitempty"`
+	BackupPolicy    *BackupPolicyV2 `json:"instance_backup_policy,omitempty"`
+}
+
+// ResizeInstanceV2Opts contains the values needed to change the instance capacity.
+type ResizeInstanceV2Opts struct {
+	SpecCode    string  `json:"spec_code" required:"true"`
+	NewCapacity float64 `json:"new_capacity" required:"true"`
+}
+
+// ResetPasswordV2Opts contains the values needed to reset the password or to enable password-free access.
+type ResetPasswordV2Opts struct {
+	NewPassword      string `json:"new_password,omitempty"`
+	NoPasswordAccess bool   `json:"no_password_access"`
+}
+
+// InstanceV2 represents DCSv2 instance.
+type InstanceV2 struct {
+	InstanceID       string          `json:"instance_id"`
+	Name             string          `json:"name"`
+	Description      string          `json:"description"`
+	Engine           string          `json:"engine"`
+	EngineVersion    string          `json:"engine_version"`
+	Capacity         float64         `json:"capacity"`
+	CapacityMinor    string          `json:"capacity_minor"`
+	SpecCode         string          `json:"spec_code"`
+	CacheMode        string          `json:"cache_mode"`
+	Status           string          `json:"status"`
+	IP               string          `json:"ip"`
+	Port             int             `json:"port"`
+	DomainName       string          `json:"domain_name"`
+	VpcID            string          `json:"vpc_id"`
+	SubnetID         string          `json:"subnet_id"`
+	SecurityGroupID  string          `json:"security_group_id"`
+	AzCodes          []string        `json:"az_codes"`
+	NoPasswordAccess string          `json:"no_password_access"`
+	MaxMemory        int             `json:"max_memory"`
+	UsedMemory       int             `json:"used_memory"`
+	MaintainBegin    string          `json:"maintain_begin"`
+	MaintainEnd      string          `json:"maintain_end"`
+	CreatedAt        string          `json:"created_at"`
+	BackupPolicy     *BackupPolicyV2 `json:"instance_backup_policy"`
+}
+
+// FlavorV2 represents DCSv2 instance flavor.
+type FlavorV2 struct {
+	SpecCode       string                `json:"spec_code"`
+	CacheMode      string                `json:"cache_mode"`
+	Engine         string                `json:"engine"`
+	EngineVersion  string                `json:"engine_version"`
+	CPUType        string                `json:"cpu_type"`
+	Capacity       []string              `json:"capacity"`
+	AvailableZones []FlavorAvailableZone `json:"flavors_available_zones"`
+	TenantIPCount  int                   `json:"tenant_ip_count"`
+	ProductType    string                `json:"product_type"`
+	StorageType    string                `json:"storage_type"`
+}
+
+// FlavorAvailableZone represents availability of the flavor.
+type FlavorAvailableZone struct {
+	Capacity       string   `json:"capacity"`
+	Unit           string   `json:"unit"`
+	AvailableZones []string `json:"available_zones"`
+	AzCodes        []string `json:"az_codes"`
+}
+
+// ListFlavorsV2Opts contains query parameters for listing flavors.
+type ListFlavorsV2Opts struct {
+	SpecCode      string `q:"spec_code"`
+	CacheMode     string `q:"cache_mode"`
+	Engine        string `q:"engine"`
+	EngineVersion string `q:"engine_version"`
+	CPUType       string `q:"cpu_type"`
+	Capacity      string `q:"capacity"`
+}
+
+// dcsRequestOpts returns new request options for each call as the client modifies them
+func dcsRequestOpts(codes ...int) *golangsdk.RequestOpts {
+	if len(codes) == 0 {
+		codes = []int{200}
+	}
+	return &golangsdk.RequestOpts{
+		OkCodes:     codes,
+		MoreHeaders: map[string]string{"Content-Type": "application/json"},
+	}
+}
+
+func createInstanceV2(client *golangsdk.ServiceClient, opts CreateInstanceV2Opts) (string, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return "", err
+	}
+	var res struct {
+		Instances []struct {
+			InstanceID string `json:"instance_id"`
+		} `json:"instances"`
+	}
+	_, err = client.Post(client.ServiceURL("instances"), b, &res, dcsRequestOpts(200))
+	if err != nil {
+		return "", err
+	}
+	if len(res.Instances) == 0 {
+		return "", fmt.Errorf("instance ID is missing in the response")
+	}
+	return res.Instances[0].InstanceID, nil
+}
+
+func GetInstanceV2(client *golangsdk.ServiceClient, id string) (*InstanceV2, error) {
+	var res InstanceV2
+	_, err := client.Get(client.ServiceURL("instances", id), &res, dcsRequestOpts(200))
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func updateInstanceV2(client *golangsdk.ServiceClient, id string, opts UpdateInstanceV2Opts) error {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return err
+	}
+	_, err = client.Put(client.ServiceURL("instances", id), b, nil, dcsRequestOpts(204))
+	return err
+}
+
+func resizeInstanceV2(client *golangsdk.ServiceClient, id string, opts ResizeInstanceV2Opts) error {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return err
+	}
+	_, err = client.Post(client.ServiceURL("instances", id, "resize"), b, nil, dcsRequestOpts(200, 204))
+	return err
+}
+
+func resetPasswordV2(client *golangsdk.ServiceClient, id string, opts ResetPasswordV2Opts) error {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return err
+	}
+	_, err = client.Post(client.ServiceURL("instances", id, "password", "reset"), b, nil, dcsRequestOpts(200, 204))
+	return err
+}
+
+func deleteInstanceV2(client *golangsdk.ServiceClient, id string) error {
+	_, err := client.Delete(client.ServiceURL("instances", id), dcsRequestOpts(204))
+	return err
+}
+
+func getInstanceV2Tags(client *golangsdk.ServiceClient, id string) ([]tags.ResourceTag, error) {
+	var res struct {
+		Tags []tags.ResourceTag `json:"tags"`
+	}
+	_, err := client.Get(client.ServiceURL("instances", id, "tags"), &res, dcsRequestOpts(200))
+	if err != nil {
+		return nil, err
+	}
+	return res.Tags, nil
+}
+
+func listFlavorsV2(client *golangsdk.ServiceClient, opts ListFlavorsV2Opts) ([]FlavorV2, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return nil, err
+	}
+	var res struct {
+		Flavors []FlavorV2 `json:"flavors"`
+	}
+	_, err = client.Get(client.ServiceURL("flavors")+q.String(), &res, dcsRequestOpts(200))
+	if err != nil {
+		return nil, err
+	}
+	return res.Flavors, nil
+}
@@ -0,0 +1,544 @@
+package dcs
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dcs/v2/whitelists"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceDcsInstanceV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDcsInstanceV2Create,
+		Read:   resourceDcsInstanceV2Read,
+		Update: resourceDcsInstanceV2Update,
+		Delete: resourceDcsInstanceV2Delete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		CustomizeDiff: checkDcsInstanceV2Whitelist,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"engine": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "Redis",
+				ValidateFunc: validation.StringInSlice([]string{
+					"Redis",
+				}, false),
+			},
+			"engine_version": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"4.0", "5.0", "6.0",
+				}, false),
+			},
+			"capacity": {
+				Type:     schema.TypeFloat,
+				Required: true,
+			},
+			"flavor": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"availability_zones": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"security_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"private_ip": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"port": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"no_password_access": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"maintain_begin": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"maintain_end": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"backup_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"save_days": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"backup_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"begin_at": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"period_type": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"backup_at": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeInt},
+						},
+					},
+				},
+			},
+			"whitelist_enable": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"whitelists": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 4,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"ip_address": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"tags": common.TagsSchema(),
+			"cache_mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"max_memory": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"used_memory": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func getDcsInstanceV2BackupPolicy(d *schema.ResourceData) *BackupPolicyV2 {
+	backupPolicyList := d.Get("backup_policy").([]interface{})
+	if len(backupPolicyList) == 0 {
+		return nil
+	}
+	backupPolicy := backupPolicyList[0].(map[string]interface{})
+	return &BackupPolicyV2{
+		SaveDays:   backupPolicy["save_days"].(int),
+		BackupType: backupPolicy["backup_type"].(string),
+		PeriodicalBackupPlan: PeriodicalBackupPlanV2{
+			BeginAt:    backupPolicy["begin_at"].(string),
+			PeriodType: backupPolicy["period_type"].(string),
+			BackupAt:   formatAts(backupPolicy["backup_at"].([]interface{})),
+		},
+	}
+}
+
+// checkDcsInstanceV2Whitelist rejects enabled whitelist without the groups, the whitelist enabled
+// in the state is disabled once all groups are removed, as it would block all clients otherwise
+func checkDcsInstanceV2Whitelist(d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("whitelists") || d.Get("whitelists").(*schema.Set).Len() != 0 {
+		return nil
+	}
+	if !d.Get("whitelist_enable").(bool) {
+		return nil
+	}
+	if d.Id() == "" || d.HasChange("whitelist_enable") {
+		return fmt.Errorf("`whitelist_enable` can't be `true` without `whitelists`")
+	}
+	return d.SetNew("whitelist_enable", false)
+}
+
+func getDcsInstanceV2Whitelist(d *schema.ResourceData) whitelists.WhitelistOpts {
+	groups := make([]whitelists.WhitelistGroupOpts, 0)
+	for _, groupRaw := range d.Get("whitelists").(*schema.Set).List() {
+		group := groupRaw.(map[string]interface{})
+		groups = append(groups, whitelists.WhitelistGroupOpts{
+			GroupName: group["group_name"].(string),
+			IPList:    common.ExpandToStringSlice(group["ip_address"].([]interface{})),
+		})
+	}
+	// whitelist is enabled by default when the groups are set and is always disabled without the groups
+	enable := len(groups) != 0
+	if v, ok := d.GetOkExists("whitelist_enable"); ok && enable {
+		enable = v.(bool)
+	}
+	return whitelists.WhitelistOpts{
+		Enable: &enable,
+		Groups: groups,
+	}
+}
+
+func putDcsInstanceV2Whitelist(d *schema.ResourceData, client *golangsdk.ServiceClient) error {
+	opts := getDcsInstanceV2Whitelist(d)
+	log.Printf("[DEBUG] Whitelist Options: %#v", opts)
+	if err := whitelists.Put(client, d.Id(), opts).ExtractErr(); err != nil {
+		return fmt.Errorf("error setting DCSv2 instance whitelist: %s", err)
+	}
+	return nil
+}
+
+func resourceDcsInstanceV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DcsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DCSv2 client: %s", err)
+	}
+
+	password := d.Get("password").(string)
+	createOpts := CreateInstanceV2Opts{
+		Name:             d.Get("name").(string),
+		Description:      d.Get("description").(string),
+		Engine:           d.Get("engine").(string),
+		EngineVersion:    d.Get("engine_version").(string),
+		Capacity:         d.Get("capacity").(float64),
+		SpecCode:         d.Get("flavor").(string),
+		AzCodes:          common.ExpandToStringSlice(d.Get("availability_zones").([]interface{})),
+		Port:             d.Get("port").(int),
+		VpcID:            d.Get("vpc_id").(string),
+		SubnetID:         d.Get("subnet_id").(string),
+		SecurityGroupID:  d.Get("security_group_id").(string),
+		PrivateIP:        d.Get("private_ip").(string),
+		Password:         password,
+		NoPasswordAccess: password == "",
+		MaintainBegin:    d.Get("maintain_begin").(string),
+		MaintainEnd:      d.Get("maintain_end").(string),
+		BackupPolicy:     getDcsInstanceV2BackupPolicy(d),
+		Tags:             common.ExpandResourceTags(d.Get("tags").(map[string]interface{})),
+	}
+	logOpts := createOpts
+	logOpts.Password = ""
+	log.Printf("[DEBUG] Create Options: %#v", logOpts)
+
+	id, err := createInstanceV2(client, createOpts)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DCSv2 instance: %s", err)
+	}
+	d.SetId(id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"CREATING"},
+		Target:     []string{"RUNNING"},
+		Refresh:    dcsInstanceV2StateRefreshFunc(client, id),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for DCSv2 instance (%s) to become ready: %s", id, err)
+	}
+
+	if _, ok := d.GetOk("whitelists"); ok {
+		if err := putDcsInstanceV2Whitelist(d, client); err != nil {
+			return err
+		}
+	}
+
+	return resourceDcsInstanceV2Read(d, meta)
+}
+
+func resourceDcsInstanceV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DcsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DCSv2 client: %s", err)
+	}
+
+	instance, err := GetInstanceV2(client, d.Id())
+	if err != nil {
+		return common.CheckDeleted(d, err, "error fetching DCSv2 instance")
+	}
+	log.Printf("[DEBUG] DCSv2 instance %s: %+v", d.Id(), instance)
+
+	capacity := instance.Capacity
+	// capacity below 1 GB is returned as `capacity_minor`, e.g. 0.125
+	if capacity == 0 && instance.CapacityMinor != "" {
+		capacity, err = strconv.ParseFloat(instance.CapacityMinor, 64)
+		if err != nil {
+			return fmt.Errorf("error parsing DCSv2 instance capacity: %s", err)
+		}
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", instance.Name),
+		d.Set("description", instance.Description),
+		d.Set("engine", instance.Engine),
+		d.Set("engine_version", instance.EngineVersion),
+		d.Set("capacity", capacity),
+		d.Set("flavor", instance.SpecCode),
+		d.Set("availability_zones", instance.AzCodes),
+		d.Set("vpc_id", instance.VpcID),
+		d.Set("subnet_id", instance.SubnetID),
+		d.Set("security_group_id", instance.SecurityGroupID),
+		d.Set("private_ip", instance.IP),
+		d.Set("port", instance.Port),
+		d.Set("no_password_access", instance.NoPasswordAccess == "true"),
+		d.Set("maintain_begin", instance.MaintainBegin),
+		d.Set("maintain_end", instance.MaintainEnd),
+		d.Set("cache_mode", instance.CacheMode),
+		d.Set("status", instance.Status),
+		d.Set("ip", instance.IP),
+		d.Set("domain_name", instance.DomainName),
+		d.Set("max_memory", instance.MaxMemory),
+		d.Set("used_memory", instance.UsedMemory),
+		d.Set("created_at", instance.CreatedAt),
+	)
+
+	whitelist, err := whitelists.Get(client, d.Id()).Extract()
+	if err != nil {
+		return fmt.Errorf("error fetching DCSv2 instance whitelist: %s", err)
+	}
+	groups := make([]map[string]interface{}, len(whitelist.Groups))
+	for i, group := range whitelist.Groups {
+		groups[i] = map[string]interface{}{
+			"group_name": group.GroupName,
+			"ip_address": group.IPList,
+		}
+	}
+	mErr = multierror.Append(mErr,
+		d.Set("whitelist_enable", whitelist.Enable),
+		d.Set("whitelists", groups),
+	)
+
+	tagList, err := getInstanceV2Tags(client, d.Id())
+	if err != nil {
+		return fmt.Errorf("error fetching DCSv2 instance tags: %s", err)
+	}
+	mErr = multierror.Append(mErr, d.Set("tags", common.TagsToMap(tagList)))
+
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmt.Errorf("error setting DCSv2 instance fields: %s", err)
+	}
+	return nil
+}
+
+func resourceDcsInstanceV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DcsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DCSv2 client: %s", err)
+	}
+
+	if d.HasChanges("name", "description", "maintain_begin", "maintain_end", "security_group_id", "backup_policy") {
+		var updateOpts UpdateInstanceV2Opts
+		if d.HasChange("name") {
+			updateOpts.Name = d.Get("name").(string)
+		}
+		if d.HasChange("description") {
+			description := d.Get("description").(string)
+			updateOpts.Description = &description
+		}
+		if d.HasChanges("maintain_begin", "maintain_end") {
+			updateOpts.MaintainBegin = d.Get("maintain_begin").(string)
+			updateOpts.MaintainEnd = d.Get("maintain_end").(string)
+		}
+		if d.HasChange("security_group_id") {
+			updateOpts.SecurityGroupID = d.Get("security_group_id").(string)
+		}
+		if d.HasChange("backup_policy") {
+			updateOpts.BackupPolicy = getDcsInstanceV2BackupPolicy(d)
+		}
+		if err := updateInstanceV2(client, d.Id(), updateOpts); err != nil {
+			return fmt.Errorf("error updating OpenTelekomCloud DCSv2 instance: %s", err)
+		}
+	}
+
+	if d.HasChanges("capacity", "flavor") {
+		resizeOpts := ResizeInstanceV2Opts{
+			SpecCode:    d.Get("flavor").(string),
+			NewCapacity: d.Get("capacity").(float64),
+		}
+		log.Printf("[DEBUG] Resize Options: %#v", resizeOpts)
+		if err := resizeInstanceV2(client, d.Id(), resizeOpts); err != nil {
+			return fmt.Errorf("error changing DCSv2 instance capacity: %s", err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"EXTENDING", "RESTARTING"},
+			Target:     []string{"RUNNING"},
+			Refresh:    dcsInstanceV2ResizeRefreshFunc(client, d.Id(), resizeOpts.SpecCode),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
+			Delay:      20 * time.Second,
+			MinTimeout: 10 * time.Second,
+		}
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("error waiting for DCSv2 instance (%s) to be resized: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("password") {
+		password := d.Get("password").(string)
+		resetOpts := ResetPasswordV2Opts{
+			NewPassword:      password,
+			NoPasswordAccess: password == "",
+		}
+		if err := resetPasswordV2(client, d.Id(), resetOpts); err != nil {
+			return fmt.Errorf("error resetting DCSv2 instance password: %s", err)
+		}
+	}
+
+	if d.HasChanges("whitelist_enable", "whitelists") {
+		if err := putDcsInstanceV2Whitelist(d, client); err != nil {
+			return err
+		}
+	}
+
+	if err := common.UpdateResourceTags(client, d, "dcs", d.Id()); err != nil {
+		return fmt.Errorf("error updating DCSv2 instance tags: %s", err)
+	}
+
+	return resourceDcsInstanceV2Read(d, meta)
+}
+
+func resourceDcsInstanceV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DcsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DCSv2 client: %s", err)
+	}
+
+	if err := deleteInstanceV2(client, d.Id()); err != nil {
+		return common.CheckDeleted(d, err, "error deleting OpenTelekomCloud DCSv2 instance")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"DELETING", "RUNNING"},
+		Target:     []string{"DELETED"},
+		Refresh:    dcsInstanceV2StateRefreshFunc(client, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for DCSv2 instance (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] DCSv2 instance %s deleted", d.Id())
+	d.SetId("")
+	return nil
+}
+
+func dcsInstanceV2StateRefreshFunc(client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		instance, err := GetInstanceV2(client, id)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return InstanceV2{}, "DELETED", nil
+			}
+			return nil, "", err
+		}
+		return instance, instance.Status, nil
+	}
+}
+
+// dcsInstanceV2ResizeRefreshFunc treats the instance as extending until the new flavor is reported
+func dcsInstanceV2ResizeRefreshFunc(client *golangsdk.ServiceClient, id, specCode string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		instance, err := GetInstanceV2(client, id)
+		if err != nil {
+			return nil, "", err
+		}
+		if instance.Status == "RUNNING" && instance.SpecCode != specCode {
+			return instance, "EXTENDING", nil
+		}
+		return instance, instance.Status, nil
+	}
+}
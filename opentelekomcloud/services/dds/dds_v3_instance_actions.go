@@ -0,0 +1,186 @@
+package dds
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dds/v3/instances"
+)
+
+// ddsRequestOpts returns new request options for each call as the client modifies them
+func ddsRequestOpts() *golangsdk.RequestOpts {
+	return &golangsdk.RequestOpts{
+		OkCodes:     []int{200, 202},
+		MoreHeaders: map[string]string{"Content-Type": "application/json"},
+	}
+}
+
+// EnlargeVolume represents the disk of the added shard.
+type EnlargeVolume struct {
+	Size int `json:"size"`
+}
+
+// EnlargeOpts contains all the values needed to add mongos or shard nodes to the cluster instance.
+type EnlargeOpts struct {
+	Type     string         `json:"type" required:"true"`
+	SpecCode string         `json:"spec_code" required:"true"`
+	Num      int            `json:"num" required:"true"`
+	Volume   *EnlargeVolume `json:"volume,omitempty"`
+}
+
+// EnlargeVolumeOpts contains all the values needed to scale up the storage.
+// `GroupID` is required for cluster instances only.
+type EnlargeVolumeOpts struct {
+	GroupID string `json:"group_id,omitempty"`
+	Size    string `json:"size" required:"true"`
+}
+
+// ResizeOpts contains all the values needed to change the node specification.
+// `TargetID` is the node ID for `mongos` and `readonly`, group ID for `shard` and `config`
+// and the instance ID for `replica`.
+type ResizeOpts struct {
+	TargetType     string `json:"target_type,omitempty"`
+	TargetID       string `json:"target_id" required:"true"`
+	TargetSpecCode string `json:"target_spec_code" required:"true"`
+}
+
+// BackupPolicy represents automated backup policy of the instance.
+type BackupPolicy struct {
+	StartTime string `json:"start_time" required:"true"`
+	KeepDays  int    `json:"keep_days"`
+}
+
+// AddReadReplicaOpts contains all the values needed to add read-only nodes to the replica set instance.
+type AddReadReplicaOpts struct {
+	SpecCode string `json:"spec_code" required:"true"`
+	Num      int    `json:"num" required:"true"`
+	Delay    int    `json:"delay,omitempty"`
+}
+
+type ddsJobResponse struct {
+	JobID string `json:"job_id"`
+}
+
+func ddsInstanceAction(client *golangsdk.ServiceClient, body interface{}, parts ...string) (string, error) {
+	var res ddsJobResponse
+	_, err := client.Post(client.ServiceURL(parts...), body, &res, ddsRequestOpts())
+	if err != nil {
+		return "", err
+	}
+	return res.JobID, nil
+}
+
+func enlargeInstance(client *golangsdk.ServiceClient, instanceID string, opts EnlargeOpts) (string, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return "", err
+	}
+	return ddsInstanceAction(client, b, "instances", instanceID, "enlarge")
+}
+
+func enlargeVolume(client *golangsdk.ServiceClient, instanceID string, opts EnlargeVolumeOpts) (string, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "volume")
+	if err != nil {
+		return "", err
+	}
+	return ddsInstanceAction(client, b, "instances", instanceID, "enlarge-volume")
+}
+
+func resizeInstance(client *golangsdk.ServiceClient, instanceID string, opts ResizeOpts) (string, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "resize")
+	if err != nil {
+		return "", err
+	}
+	return ddsInstanceAction(client, b, "instances", instanceID, "resize")
+}
+
+func updateBackupPolicy(client *golangsdk.ServiceClient, instanceID string, policy BackupPolicy) error {
+	b, err := golangsdk.BuildRequestBody(policy, "backup_policy")
+	if err != nil {
+		return err
+	}
+	_, err = client.Put(client.ServiceURL("instances", instanceID, "backups", "policy"), b, nil, ddsRequestOpts())
+	return err
+}
+
+// addReadReplicas adds read-only nodes and returns the job ID and IDs of the created nodes
+func addReadReplicas(client *golangsdk.ServiceClient, instanceID string, opts AddReadReplicaOpts) (string, []string, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return "", nil, err
+	}
+	var res struct {
+		JobID   string   `json:"job_id"`
+		NodeIDs []string `json:"node_ids"`
+	}
+	_, err = client.Post(client.ServiceURL("instances", instanceID, "readonly-node"), b, &res, ddsRequestOpts())
+	if err != nil {
+		return "", nil, err
+	}
+	return res.JobID, res.NodeIDs, nil
+}
+
+func deleteReadReplicas(client *golangsdk.ServiceClient, instanceID string, nodeIDs []string) (string, error) {
+	var res ddsJobResponse
+	opts := ddsRequestOpts()
+	opts.JSONBody = map[string]interface{}{"node_list": nodeIDs}
+	opts.JSONResponse = &res
+	_, err := client.Request("DELETE", client.ServiceURL("instances", instanceID, "readonly-node"), opts)
+	if err != nil {
+		return "", err
+	}
+	return res.JobID, nil
+}
+
+// GetDdsInstance returns the instance by its ID, `nil` is returned if the instance doesn't exist
+func GetDdsInstance(client *golangsdk.ServiceClient, instanceID string) (*instances.InstanceResponse, error) {
+	allPages, err := instances.List(client, instances.ListInstanceOpts{Id: instanceID}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	instancesList, err := instances.ExtractInstances(allPages)
+	if err != nil {
+		return nil, err
+	}
+	if instancesList.TotalCount == 0 || len(instancesList.Instances) == 0 {
+		return nil, nil
+	}
+	return &instancesList.Instances[0], nil
+}
+
+func ddsJobStateRefreshFunc(client *golangsdk.ServiceClient, jobID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		var res struct {
+			Job struct {
+				ID         string `json:"id"`
+				Status     string `json:"status"`
+				FailReason string `json:"fail_reason"`
+			} `json:"job"`
+		}
+		_, err := client.Get(fmt.Sprintf("%s?id=%s", client.ServiceURL("jobs"), jobID), &res, ddsRequestOpts())
+		if err != nil {
+			return nil, "", err
+		}
+		if res.Job.Status == "Failed" {
+			return res.Job, res.Job.Status, fmt.Errorf("DDS job %s failed: %s", jobID, res.Job.FailReason)
+		}
+		return res.Job, res.Job.Status, nil
+	}
+}
+
+func waitForDdsJob(client *golangsdk.ServiceClient, jobID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"Running"},
+		Target:     []string{"Completed"},
+		Refresh:    ddsJobStateRefreshFunc(client, jobID),
+		Timeout:    timeout,
+		Delay:      15 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for DDS job (%s) to complete: %s", jobID, err)
+	}
+	return nil
+}
@@ -3,6 +3,8 @@ package dds
 import (
 	"fmt"
 	"log"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-multierror"
@@ -27,8 +29,11 @@ func ResourceDdsInstanceV3() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: checkDdsInstanceV3Flavor,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
@@ -111,7 +116,6 @@ func ResourceDdsInstanceV3() *schema.Resource {
 			"flavor": {
 				Type:     schema.TypeList,
 				Required: true,
-				ForceNew: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"type": {
@@ -125,7 +129,6 @@ func ResourceDdsInstanceV3() *schema.Resource {
 						"num": {
 							Type:         schema.TypeInt,
 							Required:     true,
-							ForceNew:     true,
 							ValidateFunc: validation.IntBetween(1, 16),
 						},
 						"storage": {
@@ -139,12 +142,11 @@ func ResourceDdsInstanceV3() *schema.Resource {
 						"size": {
 							Type:     schema.TypeInt,
 							Optional: true,
-							ForceNew: true,
+							Computed: true,
 						},
 						"spec_code": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 						},
 					},
 				},
@@ -152,7 +154,6 @@ func ResourceDdsInstanceV3() *schema.Resource {
 			"backup_strategy": {
 				Type:     schema.TypeList,
 				Optional: true,
-				ForceNew: true,
 				Computed: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
@@ -432,6 +433,10 @@ func resourceDdsInstanceV3Read(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error setting DDSv3 backup_strategy opts: %s", err)
 	}
 
+	if err := d.Set("flavor", flattenDdsInstanceV3Flavors(d, instance)); err != nil {
+		return fmt.Errorf("error setting DDSv3 flavor: %s", err)
+	}
+
 	// save nodes attribute
 	err = d.Set("nodes", flattenDdsInstanceV3Nodes(instance))
 	if err != nil {
@@ -493,26 +498,265 @@ func resourceDdsInstanceV3Update(d *schema.ResourceData, meta interface{}) error
 		opts = append(opts, opt)
 	}
 
-	r := instances.Update(client, d.Id(), opts)
-	if r.Err != nil {
-		return fmt.Errorf("error updating instance from result: %s ", r.Err)
+	if len(opts) > 0 {
+		r := instances.Update(client, d.Id(), opts)
+		if r.Err != nil {
+			return fmt.Errorf("error updating instance from result: %s ", r.Err)
+		}
+
+		if err := waitForDdsInstanceNormal(client, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
 	}
 
+	if d.HasChange("backup_strategy") {
+		backupStrategy := resourceDdsBackupStrategy(d)
+		policy := BackupPolicy{
+			StartTime: backupStrategy.StartTime,
+			KeepDays:  backupStrategy.KeepDays,
+		}
+		if err := updateBackupPolicy(client, d.Id(), policy); err != nil {
+			return fmt.Errorf("error updating DDSv3 backup policy: %s", err)
+		}
+	}
+
+	if d.HasChange("flavor") {
+		if err := updateDdsInstanceFlavors(d, client); err != nil {
+			return err
+		}
+	}
+
+	return resourceDdsInstanceV3Read(d, meta)
+}
+
+func waitForDdsInstanceNormal(client *golangsdk.ServiceClient, instanceID string, timeout time.Duration) error {
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"updating"},
 		Target:     []string{"normal"},
-		Refresh:    DdsInstanceStateRefreshFunc(client, d.Id()),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Refresh:    DdsInstanceStateRefreshFunc(client, instanceID),
+		Timeout:    timeout,
 		Delay:      15 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err := stateConf.WaitForState()
 	if err != nil {
-		return fmt.Errorf("Error waiting for instance (%s) to become ready: %s ", d.Id(), err)
+		return fmt.Errorf("Error waiting for instance (%s) to become ready: %s ", instanceID, err)
 	}
+	return nil
+}
 
-	return resourceDdsInstanceV3Read(d, meta)
+func waitForDdsJobAndInstance(client *golangsdk.ServiceClient, instanceID, jobID string, timeout time.Duration) error {
+	if err := waitForDdsJob(client, jobID, timeout); err != nil {
+		return err
+	}
+	return waitForDdsInstanceNormal(client, instanceID, timeout)
+}
+
+func flavorsByType(flavorsRaw interface{}) map[string]map[string]interface{} {
+	result := make(map[string]map[string]interface{})
+	for _, flavorRaw := range flavorsRaw.([]interface{}) {
+		flavor := flavorRaw.(map[string]interface{})
+		result[strings.ToLower(flavor["type"].(string))] = flavor
+	}
+	return result
+}
+
+// checkDdsInstanceV3Flavor forces a new instance for flavor changes which can't be done in-place:
+// adding or removing node types, removing nodes and decreasing storage.
+func checkDdsInstanceV3Flavor(d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.HasChange("flavor") {
+		return nil
+	}
+	oldRaw, newRaw := d.GetChange("flavor")
+	if !ddsFlavorsUpdatable(flavorsByType(oldRaw), flavorsByType(newRaw)) {
+		return d.ForceNew("flavor")
+	}
+	return nil
+}
+
+func ddsFlavorsUpdatable(oldFlavors, newFlavors map[string]map[string]interface{}) bool {
+	if len(oldFlavors) != len(newFlavors) {
+		return false
+	}
+	for flavorType, newFlavor := range newFlavors {
+		oldFlavor, ok := oldFlavors[flavorType]
+		if !ok {
+			return false
+		}
+
+		oldSize, newSize := oldFlavor["size"].(int), newFlavor["size"].(int)
+		if newSize < oldSize {
+			return false
+		}
+		if newSize > oldSize && flavorType != "replica" && flavorType != "shard" {
+			return false
+		}
+
+		oldNum, newNum := oldFlavor["num"].(int), newFlavor["num"].(int)
+		if newNum < oldNum {
+			return false
+		}
+		if newNum > oldNum && flavorType != "mongos" && flavorType != "shard" {
+			return false
+		}
+	}
+	return true
+}
+
+// flattenDdsInstanceV3Flavors builds flavors from the instance groups keeping the order and
+// `storage` values of the flavors in the state, as `storage` isn't returned by the API.
+func flattenDdsInstanceV3Flavors(d *schema.ResourceData, dds instances.InstanceResponse) []map[string]interface{} {
+	var types []string
+	apiFlavors := make(map[string]map[string]interface{})
+	for _, group := range dds.Groups {
+		groupType := strings.ToLower(group.Type)
+		flavor, ok := apiFlavors[groupType]
+		if !ok {
+			flavor = map[string]interface{}{
+				"type": groupType,
+				"num":  0,
+			}
+			if size, err := strconv.Atoi(group.Volume.Size); err == nil {
+				flavor["size"] = size
+			}
+			if len(group.Nodes) > 0 {
+				flavor["spec_code"] = group.Nodes[0].SpecCode
+			}
+			apiFlavors[groupType] = flavor
+			types = append(types, groupType)
+		}
+		// mongos nodes are members of the single group, other node types are counted by groups
+		if groupType == "mongos" {
+			flavor["num"] = flavor["num"].(int) + len(group.Nodes)
+		} else {
+			flavor["num"] = flavor["num"].(int) + 1
+		}
+	}
+
+	var result []map[string]interface{}
+	for _, flavorRaw := range d.Get("flavor").([]interface{}) {
+		stateFlavor := flavorRaw.(map[string]interface{})
+		flavorType := stateFlavor["type"].(string)
+		flavor, ok := apiFlavors[strings.ToLower(flavorType)]
+		if !ok {
+			continue
+		}
+		flavor["type"] = flavorType
+		flavor["storage"] = stateFlavor["storage"]
+		result = append(result, flavor)
+		delete(apiFlavors, strings.ToLower(flavorType))
+	}
+	for _, flavorType := range types {
+		if flavor, ok := apiFlavors[flavorType]; ok {
+			result = append(result, flavor)
+		}
+	}
+	return result
+}
+
+// updateDdsInstanceFlavors changes the node specifications, scales up the storage and adds new nodes.
+// Specifications and storage of the existing nodes are changed first, so the new nodes are
+// created with the updated values.
+func updateDdsInstanceFlavors(d *schema.ResourceData, client *golangsdk.ServiceClient) error {
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	oldRaw, newRaw := d.GetChange("flavor")
+	oldFlavors := flavorsByType(oldRaw)
+	newFlavors := flavorsByType(newRaw)
+
+	instance, err := GetDdsInstance(client, d.Id())
+	if err != nil {
+		return fmt.Errorf("error fetching DDS instance: %s", err)
+	}
+	if instance == nil {
+		return fmt.Errorf("DDS instance %s not found", d.Id())
+	}
+
+	for flavorType, newFlavor := range newFlavors {
+		oldFlavor := oldFlavors[flavorType]
+
+		if oldFlavor["spec_code"].(string) != newFlavor["spec_code"].(string) {
+			specCode := newFlavor["spec_code"].(string)
+			var resizeOpts []ResizeOpts
+			switch flavorType {
+			case "replica":
+				resizeOpts = append(resizeOpts, ResizeOpts{TargetID: d.Id(), TargetSpecCode: specCode})
+			case "mongos":
+				for _, group := range instance.Groups {
+					if group.Type != flavorType {
+						continue
+					}
+					for _, node := range group.Nodes {
+						resizeOpts = append(resizeOpts, ResizeOpts{TargetType: flavorType, TargetID: node.Id, TargetSpecCode: specCode})
+					}
+				}
+			default:
+				for _, group := range instance.Groups {
+					if group.Type == flavorType {
+						resizeOpts = append(resizeOpts, ResizeOpts{TargetType: flavorType, TargetID: group.Id, TargetSpecCode: specCode})
+					}
+				}
+			}
+			for _, opts := range resizeOpts {
+				log.Printf("[DEBUG] Resize DDSv3 instance %s: %#v", d.Id(), opts)
+				jobID, err := resizeInstance(client, d.Id(), opts)
+				if err != nil {
+					return fmt.Errorf("error changing DDSv3 %s specification: %s", flavorType, err)
+				}
+				if err := waitForDdsJobAndInstance(client, d.Id(), jobID, timeout); err != nil {
+					return err
+				}
+			}
+		}
+
+		oldSize, newSize := oldFlavor["size"].(int), newFlavor["size"].(int)
+		if newSize > oldSize {
+			var volumeOpts []EnlargeVolumeOpts
+			switch flavorType {
+			case "replica":
+				volumeOpts = append(volumeOpts, EnlargeVolumeOpts{Size: strconv.Itoa(newSize)})
+			case "shard":
+				for _, group := range instance.Groups {
+					if group.Type == flavorType {
+						volumeOpts = append(volumeOpts, EnlargeVolumeOpts{GroupID: group.Id, Size: strconv.Itoa(newSize)})
+					}
+				}
+			default:
+				return fmt.Errorf("DDSv3 %s storage can't be changed", flavorType)
+			}
+			for _, opts := range volumeOpts {
+				log.Printf("[DEBUG] Enlarge DDSv3 instance %s volume: %#v", d.Id(), opts)
+				jobID, err := enlargeVolume(client, d.Id(), opts)
+				if err != nil {
+					return fmt.Errorf("error scaling up DDSv3 %s storage: %s", flavorType, err)
+				}
+				if err := waitForDdsJobAndInstance(client, d.Id(), jobID, timeout); err != nil {
+					return err
+				}
+			}
+		}
+
+		oldNum, newNum := oldFlavor["num"].(int), newFlavor["num"].(int)
+		if newNum > oldNum {
+			enlargeOpts := EnlargeOpts{
+				Type:     flavorType,
+				SpecCode: newFlavor["spec_code"].(string),
+				Num:      newNum - oldNum,
+			}
+			if flavorType == "shard" {
+				enlargeOpts.Volume = &EnlargeVolume{Size: newSize}
+			}
+			log.Printf("[DEBUG] Enlarge DDSv3 instance %s: %#v", d.Id(), enlargeOpts)
+			jobID, err := enlargeInstance(client, d.Id(), enlargeOpts)
+			if err != nil {
+				return fmt.Errorf("error adding DDSv3 %s nodes: %s", flavorType, err)
+			}
+			if err := waitForDdsJobAndInstance(client, d.Id(), jobID, timeout); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 func resourceDdsInstanceV3Delete(d *schema.ResourceData, meta interface{}) error {
@@ -0,0 +1,252 @@
+package dds
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/mutexkv"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dds/v3/instances"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+// ddsMutexKV is used to serialize node operations on the same instance,
+// DDS doesn't allow running them concurrently.
+var ddsMutexKV = mutexkv.NewMutexKV()
+
+func ResourceDdsReadReplicaV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDdsReadReplicaV3Create,
+		Read:   resourceDdsReadReplicaV3Read,
+		Update: resourceDdsReadReplicaV3Update,
+		Delete: resourceDdsReadReplicaV3Delete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceDdsReadReplicaV3Import,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"spec_code": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"delay": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"private_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func findDdsNode(instance *instances.InstanceResponse, nodeID string) *instances.Nodes {
+	for _, group := range instance.Groups {
+		for _, node := range group.Nodes {
+			if node.Id == nodeID {
+				return &node
+			}
+		}
+	}
+	return nil
+}
+
+func resourceDdsReadReplicaV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DdsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DDSv3 client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	ddsMutexKV.Lock(instanceID)
+	defer ddsMutexKV.Unlock(instanceID)
+
+	instance, err := GetDdsInstance(client, instanceID)
+	if err != nil {
+		return fmt.Errorf("error fetching DDS instance: %s", err)
+	}
+	if instance == nil {
+		return fmt.Errorf("DDS instance %s not found", instanceID)
+	}
+	if !strings.EqualFold(instance.Mode, "ReplicaSet") {
+		return fmt.Errorf("read replicas can be added to DDSv3 ReplicaSet instances only")
+	}
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+	if err := waitForDdsInstanceNormal(client, instanceID, timeout); err != nil {
+		return err
+	}
+
+	createOpts := AddReadReplicaOpts{
+		SpecCode: d.Get("spec_code").(string),
+		Num:      1,
+		Delay:    d.Get("delay").(int),
+	}
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	jobID, nodeIDs, err := addReadReplicas(client, instanceID, createOpts)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DDSv3 read replica: %s", err)
+	}
+	if len(nodeIDs) == 0 {
+		return fmt.Errorf("error creating OpenTelekomCloud DDSv3 read replica: node ID is missing in the response")
+	}
+	d.SetId(nodeIDs[0])
+
+	if err := waitForDdsJobAndInstance(client, instanceID, jobID, timeout); err != nil {
+		return err
+	}
+
+	return resourceDdsReadReplicaV3Read(d, meta)
+}
+
+func resourceDdsReadReplicaV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DdsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DDSv3 client: %s", err)
+	}
+
+	instance, err := GetDdsInstance(client, d.Get("instance_id").(string))
+	if err != nil {
+		return fmt.Errorf("error fetching DDS instance: %s", err)
+	}
+	var node *instances.Nodes
+	if instance != nil {
+		node = findDdsNode(instance, d.Id())
+	}
+	if node == nil {
+		log.Printf("[WARN] DDSv3 read replica %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", instance.Region),
+		d.Set("spec_code", node.SpecCode),
+		d.Set("name", node.Name),
+		d.Set("role", node.Role),
+		d.Set("status", node.Status),
+		d.Set("private_ip", node.PrivateIP),
+		d.Set("availability_zone", node.AvailabilityZone),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmt.Errorf("error setting DDSv3 read replica fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDdsReadReplicaV3Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DdsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DDSv3 client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	ddsMutexKV.Lock(instanceID)
+	defer ddsMutexKV.Unlock(instanceID)
+
+	if d.HasChange("spec_code") {
+		timeout := d.Timeout(schema.TimeoutUpdate)
+		if err := waitForDdsInstanceNormal(client, instanceID, timeout); err != nil {
+			return err
+		}
+		resizeOpts := ResizeOpts{
+			TargetType:     "readonly",
+			TargetID:       d.Id(),
+			TargetSpecCode: d.Get("spec_code").(string),
+		}
+		jobID, err := resizeInstance(client, instanceID, resizeOpts)
+		if err != nil {
+			return fmt.Errorf("error changing DDSv3 read replica specification: %s", err)
+		}
+		if err := waitForDdsJobAndInstance(client, instanceID, jobID, timeout); err != nil {
+			return err
+		}
+	}
+
+	return resourceDdsReadReplicaV3Read(d, meta)
+}
+
+func resourceDdsReadReplicaV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DdsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DDSv3 client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	ddsMutexKV.Lock(instanceID)
+	defer ddsMutexKV.Unlock(instanceID)
+
+	timeout := d.Timeout(schema.TimeoutDelete)
+	if err := waitForDdsInstanceNormal(client, instanceID, timeout); err != nil {
+		return err
+	}
+
+	jobID, err := deleteReadReplicas(client, instanceID, []string{d.Id()})
+	if err != nil {
+		return common.CheckDeleted(d, err, "error deleting OpenTelekomCloud DDSv3 read replica")
+	}
+	if err := waitForDdsJobAndInstance(client, instanceID, jobID, timeout); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceDdsReadReplicaV3Import(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for DDSv3 read replica, must be <instance_id>/<node_id>")
+	}
+	d.SetId(parts[1])
+	if err := d.Set("instance_id", parts[0]); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,334 @@
+package dms
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/mutexkv"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// dmsMutexKV is used to serialize topic and user operations on the same Kafka instance,
+// access policies are updated using read-modify-write.
+var dmsMutexKV = mutexkv.NewMutexKV()
+
+// CreateKafkaInstanceOpts contains all the values needed to create DMS Kafka instance.
+type CreateKafkaInstanceOpts struct {
+	Name                 string   `json:"name" required:"true"`
+	Description          string   `json:"description,omitempty"`
+	Engine               string   `json:"engine" required:"true"`
+	EngineVersion        string   `json:"engine_version" required:"true"`
+	Specification        string   `json:"specification" required:"true"`
+	StorageSpace         int      `json:"storage_space" required:"true"`
+	PartitionNum         int      `json:"partition_num" required:"true"`
+	AccessUser           string   `json:"access_user,omitempty"`
+	Password             string   `json:"password,omitempty"`
+	VpcID                string   `json:"vpc_id" required:"true"`
+	SecurityGroupID      string   `json:"security_group_id" required:"true"`
+	SubnetID             string   `json:"subnet_id" required:"true"`
+	AvailableZones       []string `json:"available_zones" required:"true"`
+	ProductID            string   `json:"product_id" required:"true"`
+	KafkaManagerUser     string   `json:"kafka_manager_user" required:"true"`
+	KafkaManagerPassword string   `json:"kafka_manager_password" required:"true"`
+	MaintainBegin        string   `json:"maintain_begin,omitempty"`
+	MaintainEnd          string   `json:"maintain_end,omitempty"`
+	SslEnable            bool     `json:"ssl_enable"`
+	EnablePublicIP       bool     `json:"enable_publicip"`
+	PublicBandwidth      int      `json:"public_bandwidth,omitempty"`
+	RetentionPolicy      string   `json:"retention_policy,omitempty"`
+	StorageSpecCode      string   `json:"storage_spec_code" required:"true"`
+	EnableAutoTopic      bool     `json:"enable_auto_topic"`
+}
+
+// UpdateKafkaInstanceOpts contains the values of DMS Kafka instance which can be updated.
+type UpdateKafkaInstanceOpts struct {
+	Name            string  `json:"name,omitempty"`
+	Description     *string `json:"description,omitempty"`
+	MaintainBegin   string  `json:"maintain_begin,omitempty"`
+	MaintainEnd     string  `json:"maintain_end,omitempty"`
+	SecurityGroupID string  `json:"security_group_id,omitempty"`
+	RetentionPolicy string  `json:"retention_policy,omitempty"`
+}
+
+// ExtendKafkaInstanceOpts contains the values needed to change the instance specification or storage.
+type ExtendKafkaInstanceOpts struct {
+	NewSpecification string `json:"new_specification,omitempty"`
+	NewStorageSpace  int    `json:"new_storage_space,omitempty"`
+}
+
+// KafkaInstance represents DMS Kafka instance.
+type KafkaInstance struct {
+	InstanceID        string `json:"instance_id"`
+	Name              string `json:"name"`
+	Description       string `json:"description"`
+	Engine            string `json:"engine"`
+	EngineVersion     string `json:"engine_version"`
+	Specification     string `json:"specification"`
+	StorageSpace      int    `json:"storage_space"`
+	UsedStorageSpace  int    `json:"used_storage_space"`
+	PartitionNum      string `json:"partition_num"`
+	ConnectAddress    string `json:"connect_address"`
+	Port              int    `json:"port"`
+	Status            string `json:"status"`
+	ResourceSpecCode  string `json:"resource_spec_code"`
+	VpcID             string `json:"vpc_id"`
+	SecurityGroupID   string `json:"security_group_id"`
+	SubnetID          string `json:"subnet_id"`
+	ProductID         string `json:"product_id"`
+	AccessUser        string `json:"access_user"`
+	KafkaManagerUser  string `json:"kafka_manager_user"`
+	MaintainBegin     string `json:"maintain_begin"`
+	MaintainEnd       string `json:"maintain_end"`
+	SslEnable         bool   `json:"ssl_enable"`
+	EnablePublicIP    bool   `json:"enable_publicip"`
+	PublicBandwidth   int    `json:"public_bandwidth"`
+	PublicConnectAddr string `json:"public_connect_address"`
+	RetentionPolicy   string `json:"retention_policy"`
+	StorageSpecCode   string `json:"storage_spec_code"`
+	EnableAutoTopic   bool   `json:"enable_auto_topic"`
+	ManagementAddress string `json:"management_connect_address"`
+	Type              string `json:"type"`
+	CreatedAt         string `json:"created_at"`
+}
+
+// KafkaTopic represents a topic of DMS Kafka instance.
+type KafkaTopic struct {
+	Name             string `json:"name"`
+	Partition        int    `json:"partition"`
+	Replication      int    `json:"replication"`
+	RetentionTime    int    `json:"retention_time"`
+	SyncReplication  bool   `json:"sync_replication"`
+	SyncMessageFlush bool   `json:"sync_message_flush"`
+}
+
+// CreateKafkaTopicOpts contains all the values needed to create a topic.
+type CreateKafkaTopicOpts struct {
+	Name             string `json:"id" required:"true"`
+	Partition        int    `json:"partition,omitempty"`
+	Replication      int    `json:"replication,omitempty"`
+	RetentionTime    int    `json:"retention_time,omitempty"`
+	SyncReplication  bool   `json:"sync_replication"`
+	SyncMessageFlush bool   `json:"sync_message_flush"`
+}
+
+// UpdateKafkaTopicOpts contains the values of the topic which can be updated.
+// Partitions number can only be increased.
+type UpdateKafkaTopicOpts struct {
+	Name                string `json:"id" required:"true"`
+	RetentionTime       int    `json:"retention_time,omitempty"`
+	SyncReplication     *bool  `json:"sync_replication,omitempty"`
+	SyncMessageFlush    *bool  `json:"sync_message_flush,omitempty"`
+	NewPartitionNumbers int    `json:"new_partition_numbers,omitempty"`
+}
+
+// KafkaUser represents SASL user of DMS Kafka instance.
+type KafkaUser struct {
+	Name        string `json:"user_name"`
+	Role        string `json:"role"`
+	DefaultApp  bool   `json:"default_app"`
+	CreatedTime int64  `json:"created_time"`
+}
+
+// KafkaAccessPolicy represents permissions of the user for the topic.
+type KafkaAccessPolicy struct {
+	UserName     string `json:"user_name" required:"true"`
+	AccessPolicy string `json:"access_policy" required:"true"`
+	Owner        bool   `json:"owner,omitempty"`
+}
+
+// dmsRequestOpts returns new request options for each call as the client modifies them
+func dmsRequestOpts(codes ...int) *golangsdk.RequestOpts {
+	if len(codes) == 0 {
+		codes = []int{200}
+	}
+	return &golangsdk.RequestOpts{
+		OkCodes:     codes,
+		MoreHeaders: map[string]string{"Content-Type": "application/json"},
+	}
+}
+
+func createKafkaInstance(client *golangsdk.ServiceClient, opts CreateKafkaInstanceOpts) (string, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return "", err
+	}
+	var res struct {
+		InstanceID string `json:"instance_id"`
+	}
+	_, err = client.Post(client.ServiceURL("instances"), b, &res, dmsRequestOpts(200))
+	if err != nil {
+		return "", err
+	}
+	if res.InstanceID == "" {
+		return "", fmt.Errorf("instance ID is missing in the response")
+	}
+	return res.InstanceID, nil
+}
+
+// GetKafkaInstance returns DMS Kafka instance by its ID
+func GetKafkaInstance(client *golangsdk.ServiceClient, id string) (*KafkaInstance, error) {
+	var res KafkaInstance
+	_, err := client.Get(client.ServiceURL("instances", id), &res, dmsRequestOpts(200))
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func updateKafkaInstance(client *golangsdk.ServiceClient, id string, opts UpdateKafkaInstanceOpts) error {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return err
+	}
+	_, err = client.Put(client.ServiceURL("instances", id), b, nil, dmsRequestOpts(204))
+	return err
+}
+
+func extendKafkaInstance(client *golangsdk.ServiceClient, id string, opts ExtendKafkaInstanceOpts) error {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return err
+	}
+	_, err = client.Post(client.ServiceURL("instances", id, "extend"), b, nil, dmsRequestOpts(200, 204))
+	return err
+}
+
+func deleteKafkaInstance(client *golangsdk.ServiceClient, id string) error {
+	_, err := client.Delete(client.ServiceURL("instances", id), dmsRequestOpts(204))
+	return err
+}
+
+func createKafkaTopic(client *golangsdk.ServiceClient, instanceID string, opts CreateKafkaTopicOpts) error {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return err
+	}
+	_, err = client.Post(client.ServiceURL("instances", instanceID, "topics"), b, nil, dmsRequestOpts(200))
+	return err
+}
+
+func listKafkaTopics(client *golangsdk.ServiceClient, instanceID string) ([]KafkaTopic, error) {
+	var res struct {
+		Topics []KafkaTopic `json:"topics"`
+	}
+	_, err := client.Get(client.ServiceURL("instances", instanceID, "topics"), &res, dmsRequestOpts(200))
+	if err != nil {
+		return nil, err
+	}
+	return res.Topics, nil
+}
+
+// GetKafkaTopic returns the topic by its name, `nil` is returned if the topic doesn't exist
+func GetKafkaTopic(client *golangsdk.ServiceClient, instanceID, name string) (*KafkaTopic, error) {
+	topics, err := listKafkaTopics(client, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	for _, topic := range topics {
+		if topic.Name == name {
+			return &topic, nil
+		}
+	}
+	return nil, nil
+}
+
+func updateKafkaTopic(client *golangsdk.ServiceClient, instanceID string, opts UpdateKafkaTopicOpts) error {
+	b := map[string]interface{}{
+		"topics": []UpdateKafkaTopicOpts{opts},
+	}
+	_, err := client.Put(client.ServiceURL("instances", instanceID, "topics"), b, nil, dmsRequestOpts(204))
+	return err
+}
+
+func deleteKafkaTopics(client *golangsdk.ServiceClient, instanceID string, names []string) error {
+	b := map[string]interface{}{
+		"topics": names,
+	}
+	_, err := client.Post(client.ServiceURL("instances", instanceID, "topics", "delete"), b, nil, dmsRequestOpts(200))
+	return err
+}
+
+func createKafkaUser(client *golangsdk.ServiceClient, instanceID, name, password string) error {
+	b := map[string]string{
+		"user_name":   name,
+		"user_passwd": password,
+	}
+	_, err := client.Post(client.ServiceURL("instances", instanceID, "users"), b, nil, dmsRequestOpts(200, 204))
+	return err
+}
+
+func listKafkaUsers(client *golangsdk.ServiceClient, instanceID string) ([]KafkaUser, error) {
+	var res struct {
+		Users []KafkaUser `json:"users"`
+	}
+	_, err := client.Get(client.ServiceURL("instances", instanceID, "users"), &res, dmsRequestOpts(200))
+	if err != nil {
+		return nil, err
+	}
+	return res.Users, nil
+}
+
+// GetKafkaUser returns the user by its name, `nil` is returned if the user doesn't exist
+func GetKafkaUser(client *golangsdk.ServiceClient, instanceID, name string) (*KafkaUser, error) {
+	users, err := listKafkaUsers(client, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	for _, user := range users {
+		if user.Name == name {
+			return &user, nil
+		}
+	}
+	return nil, nil
+}
+
+func resetKafkaUserPassword(client *golangsdk.ServiceClient, instanceID, name, password string) error {
+	b := map[string]string{
+		"new_password": password,
+	}
+	_, err := client.Put(client.ServiceURL("instances", instanceID, "users", name), b, nil, dmsRequestOpts(200, 204))
+	return err
+}
+
+func deleteKafkaUsers(client *golangsdk.ServiceClient, instanceID string, names []string) error {
+	b := map[string]interface{}{
+		"action": "delete",
+		"users":  names,
+	}
+	_, err := client.Put(client.ServiceURL("instances", instanceID, "users"), b, nil, dmsRequestOpts(200, 204))
+	return err
+}
+
+func getKafkaTopicPolicies(client *golangsdk.ServiceClient, instanceID, topic string) ([]KafkaAccessPolicy, error) {
+	var res struct {
+		Policies []KafkaAccessPolicy `json:"policies"`
+	}
+	_, err := client.Get(client.ServiceURL("instances", instanceID, "topics", topic, "accesspolicy"), &res, dmsRequestOpts(200))
+	if err != nil {
+		return nil, err
+	}
+	return res.Policies, nil
+}
+
+// setKafkaTopicPolicies replaces access policies of all non-owner users for the topic
+func setKafkaTopicPolicies(client *golangsdk.ServiceClient, instanceID, topic string, policies []KafkaAccessPolicy) error {
+	userPolicies := make([]map[string]string, 0, len(policies))
+	for _, policy := range policies {
+		if policy.Owner {
+			continue
+		}
+		userPolicies = append(userPolicies, map[string]string{
+			"user_name":     policy.UserName,
+			"access_policy": policy.AccessPolicy,
+		})
+	}
+	b := map[string]interface{}{
+		"topics": []map[string]interface{}{
+			{
+				"name":     topic,
+				"policies": userPolicies,
+			},
+		},
+	}
+	_, err := client.Post(client.ServiceURL("instances", instanceID, "topics", "accesspolicy"), b, nil, dmsRequestOpts(200, 204))
+	return err
+}
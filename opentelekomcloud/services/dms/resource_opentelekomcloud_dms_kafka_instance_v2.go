@@ -0,0 +1,498 @@
+package dms
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceDmsKafkaInstanceV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDmsKafkaInstanceV2Create,
+		Read:   resourceDmsKafkaInstanceV2Read,
+		Update: resourceDmsKafkaInstanceV2Update,
+		Delete: resourceDmsKafkaInstanceV2Delete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: checkKafkaInstanceV2Extend,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"engine_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "2.3.0",
+			},
+			"specification": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"100MB", "300MB", "600MB", "1200MB",
+				}, false),
+			},
+			"storage_space": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"storage_spec_code": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "dms.physical.storage.high",
+			},
+			"product_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"partition_num": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"security_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"available_zones": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"access_user": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"password"},
+			},
+			"password": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"access_user"},
+			},
+			"manager_user": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"manager_password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"maintain_begin": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"maintain_end": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"enable_public_ip": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"public_bandwidth": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
+			"retention_policy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"produce_reject", "time_base",
+				}, false),
+			},
+			"enable_auto_topic": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"ssl_enable": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"connect_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"public_connect_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"manager_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"used_storage_space": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"resource_spec_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// kafkaSpecifications contains supported specifications in the ascending order
+var kafkaSpecifications = []string{"100MB", "300MB", "600MB", "1200MB"}
+
+func kafkaSpecificationIndex(spec string) int {
+	for i, s := range kafkaSpecifications {
+		if s == spec {
+			return i
+		}
+	}
+	return -1
+}
+
+// checkKafkaInstanceV2Extend recreates the instance if its specification or storage is reduced,
+// only the extension can be done in place
+func checkKafkaInstanceV2Extend(d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	if d.HasChange("specification") {
+		oldSpec, newSpec := d.GetChange("specification")
+		if kafkaSpecificationIndex(newSpec.(string)) < kafkaSpecificationIndex(oldSpec.(string)) {
+			if err := d.ForceNew("specification"); err != nil {
+				return err
+			}
+		}
+	}
+	if d.HasChange("storage_space") {
+		oldSize, newSize := d.GetChange("storage_space")
+		if newSize.(int) < oldSize.(int) {
+			if err := d.ForceNew("storage_space"); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func resourceDmsKafkaInstanceV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DmsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DMSv2 client: %s", err)
+	}
+
+	createOpts := CreateKafkaInstanceOpts{
+		Name:                 d.Get("name").(string),
+		Description:          d.Get("description").(string),
+		Engine:               "kafka",
+		EngineVersion:        d.Get("engine_version").(string),
+		Specification:        d.Get("specification").(string),
+		StorageSpace:         d.Get("storage_space").(int),
+		PartitionNum:         d.Get("partition_num").(int),
+		AccessUser:           d.Get("access_user").(string),
+		Password:             d.Get("password").(string),
+		VpcID:                d.Get("vpc_id").(string),
+		SecurityGroupID:      d.Get("security_group_id").(string),
+		SubnetID:             d.Get("subnet_id").(string),
+		AvailableZones:       common.GetAllAvailableZones(d),
+		ProductID:            d.Get("product_id").(string),
+		KafkaManagerUser:     d.Get("manager_user").(string),
+		KafkaManagerPassword: d.Get("manager_password").(string),
+		MaintainBegin:        d.Get("maintain_begin").(string),
+		MaintainEnd:          d.Get("maintain_end").(string),
+		SslEnable:            d.Get("access_user").(string) != "",
+		EnablePublicIP:       d.Get("enable_public_ip").(bool),
+		PublicBandwidth:      d.Get("public_bandwidth").(int),
+		RetentionPolicy:      d.Get("retention_policy").(string),
+		StorageSpecCode:      d.Get("storage_spec_code").(string),
+		EnableAutoTopic:      d.Get("enable_auto_topic").(bool),
+	}
+	if createOpts.PartitionNum == 0 {
+		createOpts.PartitionNum = defaultKafkaPartitionNum(createOpts.Specification)
+	}
+
+	logOpts := createOpts
+	logOpts.Password = ""
+	logOpts.KafkaManagerPassword = ""
+	log.Printf("[DEBUG] Create Options: %#v", logOpts)
+	id, err := createKafkaInstance(client, createOpts)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DMS Kafka instance: %s", err)
+	}
+	log.Printf("[INFO] DMS Kafka instance ID: %s", id)
+	d.SetId(id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"CREATING"},
+		Target:     []string{"RUNNING"},
+		Refresh:    kafkaInstanceV2StateRefreshFunc(client, id),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for DMS Kafka instance (%s) to become ready: %s", id, err)
+	}
+
+	return resourceDmsKafkaInstanceV2Read(d, meta)
+}
+
+// defaultKafkaPartitionNum returns maximum number of partitions for the specification
+func defaultKafkaPartitionNum(spec string) int {
+	switch spec {
+	case "300MB":
+		return 900
+	case "600MB":
+		return 1800
+	case "1200MB":
+		return 1800
+	default:
+		return 300
+	}
+}
+
+func resourceDmsKafkaInstanceV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DmsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DMSv2 client: %s", err)
+	}
+
+	instance, err := GetKafkaInstance(client, d.Id())
+	if err != nil {
+		return common.CheckDeleted(d, err, "error fetching DMS Kafka instance")
+	}
+	log.Printf("[DEBUG] DMS Kafka instance %s: %+v", d.Id(), instance)
+
+	partitionNum, err := strconv.Atoi(instance.PartitionNum)
+	if err != nil {
+		log.Printf("[WARN] unable to parse partition number %q of DMS Kafka instance: %s", instance.PartitionNum, err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", instance.Name),
+		d.Set("description", instance.Description),
+		d.Set("engine_version", instance.EngineVersion),
+		d.Set("specification", instance.Specification),
+		d.Set("storage_space", instance.StorageSpace),
+		d.Set("storage_spec_code", instance.StorageSpecCode),
+		d.Set("product_id", instance.ProductID),
+		d.Set("partition_num", partitionNum),
+		d.Set("vpc_id", instance.VpcID),
+		d.Set("subnet_id", instance.SubnetID),
+		d.Set("security_group_id", instance.SecurityGroupID),
+		d.Set("access_user", instance.AccessUser),
+		d.Set("manager_user", instance.KafkaManagerUser),
+		d.Set("maintain_begin", instance.MaintainBegin),
+		d.Set("maintain_end", instance.MaintainEnd),
+		d.Set("enable_public_ip", instance.EnablePublicIP),
+		d.Set("public_bandwidth", instance.PublicBandwidth),
+		d.Set("retention_policy", instance.RetentionPolicy),
+		d.Set("enable_auto_topic", instance.EnableAutoTopic),
+		d.Set("ssl_enable", instance.SslEnable),
+		d.Set("status", instance.Status),
+		d.Set("connect_address", instance.ConnectAddress),
+		d.Set("public_connect_address", instance.PublicConnectAddr),
+		d.Set("manager_address", instance.ManagementAddress),
+		d.Set("port", instance.Port),
+		d.Set("used_storage_space", instance.UsedStorageSpace),
+		d.Set("resource_spec_code", instance.ResourceSpecCode),
+		d.Set("type", instance.Type),
+		d.Set("created_at", instance.CreatedAt),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmt.Errorf("error setting DMS Kafka instance fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDmsKafkaInstanceV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DmsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DMSv2 client: %s", err)
+	}
+
+	if d.HasChanges("name", "description", "maintain_begin", "maintain_end", "security_group_id", "retention_policy") {
+		var updateOpts UpdateKafkaInstanceOpts
+		if d.HasChange("name") {
+			updateOpts.Name = d.Get("name").(string)
+		}
+		if d.HasChange("description") {
+			description := d.Get("description").(string)
+			updateOpts.Description = &description
+		}
+		if d.HasChanges("maintain_begin", "maintain_end") {
+			updateOpts.MaintainBegin = d.Get("maintain_begin").(string)
+			updateOpts.MaintainEnd = d.Get("maintain_end").(string)
+		}
+		if d.HasChange("security_group_id") {
+			updateOpts.SecurityGroupID = d.Get("security_group_id").(string)
+		}
+		if d.HasChange("retention_policy") {
+			updateOpts.RetentionPolicy = d.Get("retention_policy").(string)
+		}
+		if err := updateKafkaInstance(client, d.Id(), updateOpts); err != nil {
+			return fmt.Errorf("error updating OpenTelekomCloud DMS Kafka instance: %s", err)
+		}
+	}
+
+	if d.HasChanges("specification", "storage_space") {
+		var extendOpts ExtendKafkaInstanceOpts
+		if d.HasChange("specification") {
+			extendOpts.NewSpecification = d.Get("specification").(string)
+		}
+		if d.HasChange("storage_space") {
+			extendOpts.NewStorageSpace = d.Get("storage_space").(int)
+		}
+		log.Printf("[DEBUG] Extending DMS Kafka instance %s: %#v", d.Id(), extendOpts)
+		if err := extendKafkaInstance(client, d.Id(), extendOpts); err != nil {
+			return fmt.Errorf("error extending OpenTelekomCloud DMS Kafka instance: %s", err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"EXTENDING", "PENDING"},
+			Target:     []string{"RUNNING"},
+			Refresh:    kafkaInstanceV2ExtendRefreshFunc(client, d.Id(), extendOpts),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
+			Delay:      10 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("error waiting for DMS Kafka instance (%s) to be extended: %s", d.Id(), err)
+		}
+	}
+
+	return resourceDmsKafkaInstanceV2Read(d, meta)
+}
+
+func resourceDmsKafkaInstanceV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DmsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DMSv2 client: %s", err)
+	}
+
+	if err := deleteKafkaInstance(client, d.Id()); err != nil {
+		return common.CheckDeleted(d, err, "error deleting OpenTelekomCloud DMS Kafka instance")
+	}
+
+	log.Printf("[DEBUG] Waiting for DMS Kafka instance (%s) to delete", d.Id())
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"DELETING", "RUNNING"},
+		Target:     []string{"DELETED"},
+		Refresh:    kafkaInstanceV2StateRefreshFunc(client, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for DMS Kafka instance (%s) to delete: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func kafkaInstanceV2StateRefreshFunc(client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		instance, err := GetKafkaInstance(client, id)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return instance, "DELETED", nil
+			}
+			return nil, "", err
+		}
+		return instance, instance.Status, nil
+	}
+}
+
+// kafkaInstanceV2ExtendRefreshFunc reports `PENDING` until the instance has new specification and storage,
+// as it stays `RUNNING` for a while after the request
+func kafkaInstanceV2ExtendRefreshFunc(client *golangsdk.ServiceClient, id string, opts ExtendKafkaInstanceOpts) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		instance, err := GetKafkaInstance(client, id)
+		if err != nil {
+			return nil, "", err
+		}
+		if instance.Status != "RUNNING" {
+			return instance, instance.Status, nil
+		}
+		if opts.NewSpecification != "" && instance.Specification != opts.NewSpecification {
+			return instance, "PENDING", nil
+		}
+		if opts.NewStorageSpace != 0 && instance.StorageSpace != opts.NewStorageSpace {
+			return instance, "PENDING", nil
+		}
+		return instance, instance.Status, nil
+	}
+}
@@ -0,0 +1,231 @@
+package dms
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceDmsKafkaTopicV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDmsKafkaTopicV2Create,
+		Read:   resourceDmsKafkaTopicV2Read,
+		Update: resourceDmsKafkaTopicV2Update,
+		Delete: resourceDmsKafkaTopicV2Delete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceDmsKafkaV2Import,
+		},
+
+		CustomizeDiff: checkKafkaTopicV2Partitions,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(3, 200),
+			},
+			"partition": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"replication": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      3,
+				ValidateFunc: validation.IntBetween(1, 3),
+			},
+			"retention_time": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      72,
+				ValidateFunc: validation.IntBetween(1, 168),
+			},
+			"sync_replication": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"sync_message_flush": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+		},
+	}
+}
+
+// checkKafkaTopicV2Partitions recreates the topic if number of partitions is reduced,
+// partitions can only be added in place
+func checkKafkaTopicV2Partitions(d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.HasChange("partition") {
+		return nil
+	}
+	oldNum, newNum := d.GetChange("partition")
+	if newNum.(int) < oldNum.(int) {
+		return d.ForceNew("partition")
+	}
+	return nil
+}
+
+func resourceDmsKafkaTopicV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DmsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DMSv2 client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	createOpts := CreateKafkaTopicOpts{
+		Name:             d.Get("name").(string),
+		Partition:        d.Get("partition").(int),
+		Replication:      d.Get("replication").(int),
+		RetentionTime:    d.Get("retention_time").(int),
+		SyncReplication:  d.Get("sync_replication").(bool),
+		SyncMessageFlush: d.Get("sync_message_flush").(bool),
+	}
+
+	dmsMutexKV.Lock(instanceID)
+	defer dmsMutexKV.Unlock(instanceID)
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	if err := createKafkaTopic(client, instanceID, createOpts); err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DMS Kafka topic: %s", err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, createOpts.Name))
+
+	return resourceDmsKafkaTopicV2Read(d, meta)
+}
+
+func resourceDmsKafkaTopicV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DmsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DMSv2 client: %s", err)
+	}
+
+	topic, err := GetKafkaTopic(client, d.Get("instance_id").(string), d.Get("name").(string))
+	if err != nil {
+		return common.CheckDeleted(d, err, "error fetching DMS Kafka topic")
+	}
+	if topic == nil {
+		log.Printf("[WARN] DMS Kafka topic %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", topic.Name),
+		d.Set("partition", topic.Partition),
+		d.Set("replication", topic.Replication),
+		d.Set("retention_time", topic.RetentionTime),
+		d.Set("sync_replication", topic.SyncReplication),
+		d.Set("sync_message_flush", topic.SyncMessageFlush),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmt.Errorf("error setting DMS Kafka topic fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDmsKafkaTopicV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DmsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DMSv2 client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	updateOpts := UpdateKafkaTopicOpts{
+		Name: d.Get("name").(string),
+	}
+	if d.HasChange("partition") {
+		updateOpts.NewPartitionNumbers = d.Get("partition").(int)
+	}
+	if d.HasChange("retention_time") {
+		updateOpts.RetentionTime = d.Get("retention_time").(int)
+	}
+	if d.HasChange("sync_replication") {
+		syncReplication := d.Get("sync_replication").(bool)
+		updateOpts.SyncReplication = &syncReplication
+	}
+	if d.HasChange("sync_message_flush") {
+		syncMessageFlush := d.Get("sync_message_flush").(bool)
+		updateOpts.SyncMessageFlush = &syncMessageFlush
+	}
+
+	dmsMutexKV.Lock(instanceID)
+	defer dmsMutexKV.Unlock(instanceID)
+
+	log.Printf("[DEBUG] Updating DMS Kafka topic %s: %#v", d.Id(), updateOpts)
+	if err := updateKafkaTopic(client, instanceID, updateOpts); err != nil {
+		return fmt.Errorf("error updating OpenTelekomCloud DMS Kafka topic: %s", err)
+	}
+
+	return resourceDmsKafkaTopicV2Read(d, meta)
+}
+
+func resourceDmsKafkaTopicV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DmsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DMSv2 client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	dmsMutexKV.Lock(instanceID)
+	defer dmsMutexKV.Unlock(instanceID)
+
+	log.Printf("[DEBUG] Deleting DMS Kafka topic %s", d.Id())
+	if err := deleteKafkaTopics(client, instanceID, []string{d.Get("name").(string)}); err != nil {
+		return common.CheckDeleted(d, err, "error deleting OpenTelekomCloud DMS Kafka topic")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func parseDmsKafkaResourceID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid ID format, must be <instance_id>/<name>")
+	}
+	return parts[0], parts[1], nil
+}
+
+func resourceDmsKafkaV2Import(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	instanceID, name, err := parseDmsKafkaResourceID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	mErr := multierror.Append(nil,
+		d.Set("instance_id", instanceID),
+		d.Set("name", name),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
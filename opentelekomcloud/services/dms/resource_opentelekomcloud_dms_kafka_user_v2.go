@@ -0,0 +1,271 @@
+package dms
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceDmsKafkaUserV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDmsKafkaUserV2Create,
+		Read:   resourceDmsKafkaUserV2Read,
+		Update: resourceDmsKafkaUserV2Update,
+		Delete: resourceDmsKafkaUserV2Delete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceDmsKafkaV2Import,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(4, 64),
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"permissions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"topic": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"access_policy": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"all", "pub", "sub",
+							}, false),
+						},
+					},
+				},
+			},
+			"role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_app": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// expandKafkaUserPermissions returns access policy for each topic
+func expandKafkaUserPermissions(v interface{}) map[string]string {
+	result := make(map[string]string)
+	for _, raw := range v.(*schema.Set).List() {
+		permission := raw.(map[string]interface{})
+		result[permission["topic"].(string)] = permission["access_policy"].(string)
+	}
+	return result
+}
+
+// updateKafkaUserPermissions sets user access policies for the topics, removing policies of the topics
+// which are not in `permissions` but were in `oldPermissions`
+func updateKafkaUserPermissions(client *golangsdk.ServiceClient, instanceID, userName string, oldPermissions, permissions map[string]string) error {
+	var topics []string
+	for topic := range oldPermissions {
+		if _, ok := permissions[topic]; !ok {
+			topics = append(topics, topic)
+		}
+	}
+	for topic, policy := range permissions {
+		if oldPermissions[topic] != policy {
+			topics = append(topics, topic)
+		}
+	}
+	sort.Strings(topics)
+
+	for _, topic := range topics {
+		current, err := getKafkaTopicPolicies(client, instanceID, topic)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok && permissions[topic] == "" {
+				continue
+			}
+			return fmt.Errorf("error fetching DMS Kafka topic %s access policies: %s", topic, err)
+		}
+		policies := make([]KafkaAccessPolicy, 0, len(current)+1)
+		for _, policy := range current {
+			if policy.UserName != userName {
+				policies = append(policies, policy)
+			}
+		}
+		if policy, ok := permissions[topic]; ok {
+			policies = append(policies, KafkaAccessPolicy{
+				UserName:     userName,
+				AccessPolicy: policy,
+			})
+		}
+		log.Printf("[DEBUG] Setting DMS Kafka topic %s access policies: %#v", topic, policies)
+		if err := setKafkaTopicPolicies(client, instanceID, topic, policies); err != nil {
+			return fmt.Errorf("error setting DMS Kafka topic %s access policies: %s", topic, err)
+		}
+	}
+	return nil
+}
+
+func resourceDmsKafkaUserV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DmsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DMSv2 client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	name := d.Get("name").(string)
+
+	dmsMutexKV.Lock(instanceID)
+	defer dmsMutexKV.Unlock(instanceID)
+
+	log.Printf("[DEBUG] Creating DMS Kafka user %s", name)
+	if err := createKafkaUser(client, instanceID, name, d.Get("password").(string)); err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DMS Kafka user: %s", err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, name))
+
+	permissions := expandKafkaUserPermissions(d.Get("permissions"))
+	if err := updateKafkaUserPermissions(client, instanceID, name, nil, permissions); err != nil {
+		return err
+	}
+
+	return resourceDmsKafkaUserV2Read(d, meta)
+}
+
+func resourceDmsKafkaUserV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DmsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DMSv2 client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	user, err := GetKafkaUser(client, instanceID, d.Get("name").(string))
+	if err != nil {
+		return common.CheckDeleted(d, err, "error fetching DMS Kafka user")
+	}
+	if user == nil {
+		log.Printf("[WARN] DMS Kafka user %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	topics, err := listKafkaTopics(client, instanceID)
+	if err != nil {
+		return fmt.Errorf("error listing DMS Kafka topics: %s", err)
+	}
+	var permissions []map[string]interface{}
+	for _, topic := range topics {
+		policies, err := getKafkaTopicPolicies(client, instanceID, topic.Name)
+		if err != nil {
+			return fmt.Errorf("error fetching DMS Kafka topic %s access policies: %s", topic.Name, err)
+		}
+		for _, policy := range policies {
+			if policy.UserName == user.Name && !policy.Owner {
+				permissions = append(permissions, map[string]interface{}{
+					"topic":         topic.Name,
+					"access_policy": policy.AccessPolicy,
+				})
+			}
+		}
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", user.Name),
+		d.Set("role", user.Role),
+		d.Set("default_app", user.DefaultApp),
+		d.Set("permissions", permissions),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmt.Errorf("error setting DMS Kafka user fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDmsKafkaUserV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DmsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DMSv2 client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	name := d.Get("name").(string)
+
+	dmsMutexKV.Lock(instanceID)
+	defer dmsMutexKV.Unlock(instanceID)
+
+	if d.HasChange("password") {
+		if err := resetKafkaUserPassword(client, instanceID, name, d.Get("password").(string)); err != nil {
+			return fmt.Errorf("error resetting OpenTelekomCloud DMS Kafka user password: %s", err)
+		}
+	}
+
+	if d.HasChange("permissions") {
+		oldPermissions, newPermissions := d.GetChange("permissions")
+		err := updateKafkaUserPermissions(client, instanceID, name,
+			expandKafkaUserPermissions(oldPermissions), expandKafkaUserPermissions(newPermissions))
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceDmsKafkaUserV2Read(d, meta)
+}
+
+func resourceDmsKafkaUserV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.DmsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud DMSv2 client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	name := d.Get("name").(string)
+
+	dmsMutexKV.Lock(instanceID)
+	defer dmsMutexKV.Unlock(instanceID)
+
+	permissions := expandKafkaUserPermissions(d.Get("permissions"))
+	if err := updateKafkaUserPermissions(client, instanceID, name, permissions, nil); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting DMS Kafka user %s", d.Id())
+	if err := deleteKafkaUsers(client, instanceID, []string{name}); err != nil {
+		return common.CheckDeleted(d, err, "error deleting OpenTelekomCloud DMS Kafka user")
+	}
+
+	d.SetId("")
+	return nil
+}
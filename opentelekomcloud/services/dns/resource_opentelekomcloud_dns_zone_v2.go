@@ -15,6 +15,7 @@ import (
 
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/eps"
 )
 
 var serviceMap = map[string]string{
@@ -98,6 +99,11 @@ func ResourceDNSZoneV2() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -142,13 +148,14 @@ func resourceDNSZoneV2Create(d *schema.ResourceData, meta interface{}) error {
 	vs["router"] = resourceDNSRouter(d)
 
 	createOpts := ZoneCreateOpts{
-		zones.CreateOpts{
+		CreateOpts: zones.CreateOpts{
 			Name:        d.Get("name").(string),
 			TTL:         d.Get("ttl").(int),
 			Email:       d.Get("email").(string),
 			Description: d.Get("description").(string),
 		},
-		vs,
+		ValueSpecs:          vs,
+		EnterpriseProjectID: d.Get("enterprise_project_id").(string),
 	}
 
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
@@ -233,10 +240,15 @@ func resourceDNSZoneV2Read(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating OpenTelekomCloud DNS client: %s", err)
 	}
 
-	n, err := zones.Get(dnsClient, d.Id()).Extract()
+	result := zones.Get(dnsClient, d.Id())
+	n, err := result.Extract()
 	if err != nil {
 		return common.CheckDeleted(d, err, "zone")
 	}
+	epsID, err := eps.ExtractEnterpriseProjectID(result, "")
+	if err != nil {
+		return fmt.Errorf("error extracting enterprise project ID of DNS zone: %s", err)
+	}
 
 	log.Printf("[DEBUG] Retrieved Zone %s: %#v", d.Id(), n)
 
@@ -245,6 +257,7 @@ func resourceDNSZoneV2Read(d *schema.ResourceData, meta interface{}) error {
 	d.Set("description", n.Description)
 	d.Set("ttl", n.TTL)
 	d.Set("type", n.ZoneType)
+	d.Set("enterprise_project_id", epsID)
 	if err = d.Set("masters", n.Masters); err != nil {
 		return fmt.Errorf("[DEBUG] Error saving masters to state for OpenTelekomCloud DNS zone (%s): %s", d.Id(), err)
 	}
@@ -381,6 +394,14 @@ func resourceDNSZoneV2Update(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error updating tags of DNS zone %s: %s", d.Id(), tagErr)
 	}
 
+	migrateOpts := eps.MigrateResourceOpts{
+		ResourceType: serviceMap[zone_type],
+		ResourceID:   d.Id(),
+	}
+	if err := eps.MigrateResource(d, config, migrateOpts); err != nil {
+		return err
+	}
+
 	return resourceDNSZoneV2Read(d, meta)
 }
 
@@ -33,11 +33,12 @@ func (opts RecordSetCreateOpts) ToRecordSetCreateMap() (map[string]interface{},
 // ZoneCreateOpts represents the attributes used when creating a new DNS zone.
 type ZoneCreateOpts struct {
 	zones.CreateOpts
-	ValueSpecs map[string]interface{} `json:"value_specs,omitempty"`
+	ValueSpecs          map[string]interface{} `json:"value_specs,omitempty"`
+	EnterpriseProjectID string                 `json:"enterprise_project_id,omitempty"`
 }
 
 // ToZoneCreateMap casts a CreateOpts struct to a map.
-// It overrides zones.ToZoneCreateMap to add the ValueSpecs field.
+// It overrides zones.ToZoneCreateMap to add the ValueSpecs and EnterpriseProjectID fields.
 func (opts ZoneCreateOpts) ToZoneCreateMap() (map[string]interface{}, error) {
 	b, err := common.BuildRequest(opts, "")
 	if err != nil {
@@ -19,6 +19,7 @@ import (
 
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/eps"
 )
 
 func ResourceEcsInstanceV1() *schema.Resource {
@@ -179,6 +180,11 @@ func ResourceEcsInstanceV1() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -209,6 +215,11 @@ func resourceEcsInstanceV1Create(d *schema.ResourceData, meta interface{}) error
 		AdminPass:        d.Get("password").(string),
 		UserData:         []byte(d.Get("user_data").(string)),
 	}
+	if epsID := d.Get("enterprise_project_id").(string); epsID != "" {
+		createOpts.ExtendParam = &cloudservers.ServerExtendParam{
+			EnterpriseProjectId: epsID,
+		}
+	}
 
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
 
@@ -275,6 +286,7 @@ func resourceEcsInstanceV1Read(d *schema.ResourceData, meta interface{}) error {
 		d.Set("key_name", server.KeyName),
 		d.Set("vpc_id", server.Metadata.VpcID),
 		d.Set("availability_zone", server.AvailabilityZone),
+		d.Set("enterprise_project_id", server.EnterpriseProjectID),
 	)
 	var secGrpNames []string
 	for _, sg := range server.SecurityGroups {
@@ -459,6 +471,14 @@ func resourceEcsInstanceV1Update(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	migrateOpts := eps.MigrateResourceOpts{
+		ResourceType: "ecs",
+		ResourceID:   d.Id(),
+	}
+	if err := eps.MigrateResource(d, config, migrateOpts); err != nil {
+		return err
+	}
+
 	return resourceEcsInstanceV1Read(d, meta)
 }
 
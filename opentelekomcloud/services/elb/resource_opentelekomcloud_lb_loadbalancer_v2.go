@@ -14,6 +14,7 @@ import (
 
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/eps"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/vpc"
 )
 
@@ -94,6 +95,12 @@ func ResourceLoadBalancerV2() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Set:      schema.HashString,
 			},
+
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -111,14 +118,17 @@ func resourceLoadBalancerV2Create(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	adminStateUp := d.Get("admin_state_up").(bool)
-	createOpts := loadbalancers.CreateOpts{
-		Name:         d.Get("name").(string),
-		Description:  d.Get("description").(string),
-		VipSubnetID:  d.Get("vip_subnet_id").(string),
-		TenantID:     d.Get("tenant_id").(string),
-		VipAddress:   d.Get("vip_address").(string),
-		AdminStateUp: &adminStateUp,
-		Provider:     lbProvider,
+	createOpts := LoadBalancerCreateOpts{
+		CreateOpts: loadbalancers.CreateOpts{
+			Name:         d.Get("name").(string),
+			Description:  d.Get("description").(string),
+			VipSubnetID:  d.Get("vip_subnet_id").(string),
+			TenantID:     d.Get("tenant_id").(string),
+			VipAddress:   d.Get("vip_address").(string),
+			AdminStateUp: &adminStateUp,
+			Provider:     lbProvider,
+		},
+		EnterpriseProjectID: d.Get("enterprise_project_id").(string),
 	}
 
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
@@ -153,10 +163,15 @@ func resourceLoadBalancerV2Read(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
 	}
 
-	lb, err := loadbalancers.Get(networkingClient, d.Id()).Extract()
+	result := loadbalancers.Get(networkingClient, d.Id())
+	lb, err := result.Extract()
 	if err != nil {
 		return common.CheckDeleted(d, err, "loadbalancer")
 	}
+	epsID, err := eps.ExtractEnterpriseProjectID(result, "loadbalancer")
+	if err != nil {
+		return fmt.Errorf("error extracting enterprise project ID of loadbalancer: %s", err)
+	}
 
 	log.Printf("[DEBUG] Retrieved loadbalancer %s: %#v", d.Id(), lb)
 
@@ -168,6 +183,7 @@ func resourceLoadBalancerV2Read(d *schema.ResourceData, meta interface{}) error
 	d.Set("vip_port_id", lb.VipPortID)
 	d.Set("admin_state_up", lb.AdminStateUp)
 	d.Set("loadbalancer_provider", lb.Provider)
+	d.Set("enterprise_project_id", epsID)
 	d.Set("region", config.GetRegion(d))
 
 	// Get any security groups on the VIP Port
@@ -237,6 +253,14 @@ func resourceLoadBalancerV2Update(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
+	migrateOpts := eps.MigrateResourceOpts{
+		ResourceType: "loadbalancers",
+		ResourceID:   d.Id(),
+	}
+	if err := eps.MigrateResource(d, config, migrateOpts); err != nil {
+		return err
+	}
+
 	return resourceLoadBalancerV2Read(d, meta)
 }
 
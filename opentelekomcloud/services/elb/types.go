@@ -0,0 +1,18 @@
+package elb
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/lbaas_v2/loadbalancers"
+)
+
+// LoadBalancerCreateOpts represents the attributes used when creating a new load balancer.
+type LoadBalancerCreateOpts struct {
+	loadbalancers.CreateOpts
+	EnterpriseProjectID string `json:"enterprise_project_id,omitempty"`
+}
+
+// ToLoadBalancerCreateMap casts a CreateOpts struct to a map.
+// It overrides loadbalancers.ToLoadBalancerCreateMap to add the EnterpriseProjectID field.
+func (opts LoadBalancerCreateOpts) ToLoadBalancerCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "loadbalancer")
+}
@@ -0,0 +1,110 @@
+package eps
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func DataSourceEnterpriseProject() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceEnterpriseProjectRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.IntInSlice([]int{
+					enterpriseProjectEnabled, enterpriseProjectDisabled,
+				}),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceEnterpriseProjectRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.EpsV1Client()
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud EPS client: %s", err)
+	}
+
+	listOpts := EnterpriseProjectListOpts{
+		ID:     d.Get("id").(string),
+		Name:   d.Get("name").(string),
+		Status: d.Get("status").(int),
+	}
+	log.Printf("[DEBUG] List Options: %#v", listOpts)
+
+	allProjects, err := listEnterpriseProjects(client, listOpts)
+	if err != nil {
+		return fmt.Errorf("unable to query enterprise projects: %s", err)
+	}
+
+	// API filters projects by the part of the name
+	var projects []EnterpriseProject
+	for _, project := range allProjects {
+		if listOpts.Name != "" && project.Name != listOpts.Name {
+			continue
+		}
+		projects = append(projects, project)
+	}
+
+	if len(projects) < 1 {
+		return fmt.Errorf("your query returned no results, please change your search criteria and try again")
+	}
+	if len(projects) > 1 {
+		log.Printf("[DEBUG] Multiple results found: %#v", projects)
+		return fmt.Errorf("your query returned more than one result, please try a more specific search criteria")
+	}
+	project := projects[0]
+	log.Printf("[DEBUG] Single enterprise project found: %s", project.ID)
+
+	d.SetId(project.ID)
+	mErr := multierror.Append(nil,
+		d.Set("name", project.Name),
+		d.Set("status", project.Status),
+		d.Set("description", project.Description),
+		d.Set("type", project.Type),
+		d.Set("enabled", project.Status == enterpriseProjectEnabled),
+		d.Set("created_at", project.CreatedAt),
+		d.Set("updated_at", project.UpdatedAt),
+	)
+	return mErr.ErrorOrNil()
+}
@@ -0,0 +1,191 @@
+package eps
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const (
+	enterpriseProjectEnabled  = 1
+	enterpriseProjectDisabled = 2
+)
+
+// EnterpriseProject represents the enterprise project.
+type EnterpriseProject struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Status      int    `json:"status"`
+	Type        string `json:"type"`
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+}
+
+// EnterpriseProjectOpts contains the values used for the enterprise project creation and update.
+type EnterpriseProjectOpts struct {
+	Name        string `json:"name" required:"true"`
+	Description string `json:"description"`
+	Type        string `json:"type,omitempty"`
+}
+
+// EnterpriseProjectListOpts contains the values used to filter the enterprise projects.
+type EnterpriseProjectListOpts struct {
+	ID     string
+	Name   string
+	Status int
+}
+
+// MigrateResourceOpts contains the values used for the resource migration between the enterprise projects.
+type MigrateResourceOpts struct {
+	ResourceType string `json:"resource_type" required:"true"`
+	ResourceID   string `json:"resource_id" required:"true"`
+	ProjectID    string `json:"project_id,omitempty"`
+	RegionID     string `json:"region_id,omitempty"`
+	// Associated defines if the resources associated with the migrated one (e.g. EVS and EIP of ECS) are migrated too
+	Associated bool `json:"associated"`
+}
+
+func epsRequestOpts(codes ...int) *golangsdk.RequestOpts {
+	return &golangsdk.RequestOpts{
+		OkCodes: codes,
+	}
+}
+
+func enterpriseProjectURL(client *golangsdk.ServiceClient, parts ...string) string {
+	return client.ServiceURL(append([]string{"enterprise-projects"}, parts...)...)
+}
+
+func createEnterpriseProject(client *golangsdk.ServiceClient, opts EnterpriseProjectOpts) (*EnterpriseProject, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+	var res struct {
+		Project EnterpriseProject `json:"enterprise_project"`
+	}
+	if _, err := client.Post(enterpriseProjectURL(client), b, &res, epsRequestOpts(200)); err != nil {
+		return nil, err
+	}
+	return &res.Project, nil
+}
+
+func getEnterpriseProject(client *golangsdk.ServiceClient, id string) (*EnterpriseProject, error) {
+	var res struct {
+		Project EnterpriseProject `json:"enterprise_project"`
+	}
+	if _, err := client.Get(enterpriseProjectURL(client, id), &res, epsRequestOpts(200)); err != nil {
+		return nil, err
+	}
+	return &res.Project, nil
+}
+
+func updateEnterpriseProject(client *golangsdk.ServiceClient, id string, opts EnterpriseProjectOpts) error {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return err
+	}
+	_, err = client.Put(enterpriseProjectURL(client, id), b, nil, epsRequestOpts(200))
+	return err
+}
+
+// setEnterpriseProjectEnabled enables or disables the enterprise project,
+// disabling is the only way to "delete" the enterprise project
+func setEnterpriseProjectEnabled(client *golangsdk.ServiceClient, id string, enabled bool) error {
+	action := "disable"
+	if enabled {
+		action = "enable"
+	}
+	b := map[string]string{
+		"action": action,
+	}
+	_, err := client.Post(enterpriseProjectURL(client, id, "action"), b, nil, epsRequestOpts(200, 204))
+	return err
+}
+
+func listEnterpriseProjects(client *golangsdk.ServiceClient, opts EnterpriseProjectListOpts) ([]EnterpriseProject, error) {
+	query := url.Values{}
+	if opts.ID != "" {
+		query.Set("id", opts.ID)
+	}
+	if opts.Name != "" {
+		query.Set("name", opts.Name)
+	}
+	if opts.Status != 0 {
+		query.Set("status", fmt.Sprint(opts.Status))
+	}
+	u := enterpriseProjectURL(client)
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+
+	var res struct {
+		Projects []EnterpriseProject `json:"enterprise_projects"`
+	}
+	if _, err := client.Get(u, &res, epsRequestOpts(200)); err != nil {
+		return nil, err
+	}
+	return res.Projects, nil
+}
+
+func migrateResource(client *golangsdk.ServiceClient, projectID string, opts MigrateResourceOpts) error {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return err
+	}
+	_, err = client.Post(enterpriseProjectURL(client, projectID, "resources-migrate"), b, nil, epsRequestOpts(200, 204))
+	return err
+}
+
+// MigrateResource moves the resource to the enterprise project set in `enterprise_project_id`
+// if it has been changed. Project and region of the provider are used if not set in `opts`.
+func MigrateResource(d *schema.ResourceData, config *cfg.Config, opts MigrateResourceOpts) error {
+	if !d.HasChange("enterprise_project_id") {
+		return nil
+	}
+	projectID := d.Get("enterprise_project_id").(string)
+	if projectID == "" {
+		return nil
+	}
+
+	client, err := config.EpsV1Client()
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud EPS client: %s", err)
+	}
+
+	if opts.ProjectID == "" {
+		opts.ProjectID = config.HwClient.ProjectID
+	}
+	if opts.RegionID == "" {
+		opts.RegionID = config.GetRegion(d)
+	}
+
+	log.Printf("[DEBUG] Migrating %s %s to enterprise project %s", opts.ResourceType, opts.ResourceID, projectID)
+	if err := migrateResource(client, projectID, opts); err != nil {
+		return fmt.Errorf("error migrating %s %s to enterprise project %s: %s",
+			opts.ResourceType, opts.ResourceID, projectID, err)
+	}
+	return nil
+}
+
+// StructExtractor is implemented by SDK results
+type StructExtractor interface {
+	ExtractIntoStructPtr(to interface{}, label string) error
+}
+
+// ExtractEnterpriseProjectID extracts `enterprise_project_id` of the resource
+// from the SDK result which doesn't expose this field
+func ExtractEnterpriseProjectID(r StructExtractor, label string) (string, error) {
+	var res struct {
+		EnterpriseProjectID string `json:"enterprise_project_id"`
+	}
+	if err := r.ExtractIntoStructPtr(&res, label); err != nil {
+		return "", err
+	}
+	return res.EnterpriseProjectID, nil
+}
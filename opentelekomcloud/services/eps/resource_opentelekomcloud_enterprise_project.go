@@ -0,0 +1,170 @@
+package eps
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceEnterpriseProject() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceEnterpriseProjectCreate,
+		Read:   resourceEnterpriseProjectRead,
+		Update: resourceEnterpriseProjectUpdate,
+		Delete: resourceEnterpriseProjectDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "prod",
+				ValidateFunc: validation.StringInSlice([]string{
+					"prod", "poc",
+				}, false),
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"status": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceEnterpriseProjectCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.EpsV1Client()
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud EPS client: %s", err)
+	}
+
+	opts := EnterpriseProjectOpts{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Type:        d.Get("type").(string),
+	}
+	log.Printf("[DEBUG] Create Options: %#v", opts)
+	project, err := createEnterpriseProject(client, opts)
+	if err != nil {
+		return fmt.Errorf("error creating enterprise project: %s", err)
+	}
+
+	d.SetId(project.ID)
+
+	if !d.Get("enabled").(bool) {
+		if err := setEnterpriseProjectEnabled(client, d.Id(), false); err != nil {
+			return fmt.Errorf("error disabling enterprise project: %s", err)
+		}
+	}
+
+	return resourceEnterpriseProjectRead(d, meta)
+}
+
+func resourceEnterpriseProjectRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.EpsV1Client()
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud EPS client: %s", err)
+	}
+
+	project, err := getEnterpriseProject(client, d.Id())
+	if err != nil {
+		return common.CheckDeleted(d, err, "enterprise project")
+	}
+	log.Printf("[DEBUG] Retrieved enterprise project: %#v", project)
+
+	mErr := multierror.Append(nil,
+		d.Set("name", project.Name),
+		d.Set("description", project.Description),
+		d.Set("type", project.Type),
+		d.Set("enabled", project.Status == enterpriseProjectEnabled),
+		d.Set("status", project.Status),
+		d.Set("created_at", project.CreatedAt),
+		d.Set("updated_at", project.UpdatedAt),
+	)
+	return mErr.ErrorOrNil()
+}
+
+func resourceEnterpriseProjectUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.EpsV1Client()
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud EPS client: %s", err)
+	}
+
+	if d.HasChanges("name", "description") {
+		opts := EnterpriseProjectOpts{
+			Name:        d.Get("name").(string),
+			Description: d.Get("description").(string),
+		}
+		log.Printf("[DEBUG] Update Options: %#v", opts)
+		if err := updateEnterpriseProject(client, d.Id(), opts); err != nil {
+			return fmt.Errorf("error updating enterprise project: %s", err)
+		}
+	}
+
+	if d.HasChange("enabled") {
+		if err := setEnterpriseProjectEnabled(client, d.Id(), d.Get("enabled").(bool)); err != nil {
+			return fmt.Errorf("error changing enterprise project status: %s", err)
+		}
+	}
+
+	return resourceEnterpriseProjectRead(d, meta)
+}
+
+// resourceEnterpriseProjectDelete disables the enterprise project as enterprise projects can't be deleted
+func resourceEnterpriseProjectDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.EpsV1Client()
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud EPS client: %s", err)
+	}
+
+	project, err := getEnterpriseProject(client, d.Id())
+	if err != nil {
+		return common.CheckDeleted(d, err, "enterprise project")
+	}
+
+	if project.Status != enterpriseProjectDisabled {
+		if err := setEnterpriseProjectEnabled(client, d.Id(), false); err != nil {
+			return fmt.Errorf("error disabling enterprise project: %s", err)
+		}
+	}
+	log.Printf("[WARN] Enterprise project %s can't be deleted, it has been disabled instead", d.Id())
+
+	d.SetId("")
+	return nil
+}
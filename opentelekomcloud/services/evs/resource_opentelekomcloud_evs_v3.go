@@ -16,6 +16,7 @@ import (
 
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/eps"
 )
 
 func ResourceEvsStorageVolumeV3() *schema.Resource {
@@ -131,6 +132,11 @@ func ResourceEvsStorageVolumeV3() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -156,16 +162,17 @@ func resourceEvsVolumeV3Create(d *schema.ResourceData, meta interface{}) error {
 	}
 	tags := resourceContainerTags(d)
 	createOpts := &volumes.CreateOpts{
-		BackupID:         d.Get("backup_id").(string),
-		AvailabilityZone: d.Get("availability_zone").(string),
-		Description:      d.Get("description").(string),
-		Size:             d.Get("size").(int),
-		Name:             d.Get("name").(string),
-		SnapshotID:       d.Get("snapshot_id").(string),
-		ImageRef:         d.Get("image_id").(string),
-		VolumeType:       d.Get("volume_type").(string),
-		Multiattach:      d.Get("multiattach").(bool),
-		Tags:             tags,
+		BackupID:            d.Get("backup_id").(string),
+		AvailabilityZone:    d.Get("availability_zone").(string),
+		Description:         d.Get("description").(string),
+		Size:                d.Get("size").(int),
+		Name:                d.Get("name").(string),
+		SnapshotID:          d.Get("snapshot_id").(string),
+		ImageRef:            d.Get("image_id").(string),
+		VolumeType:          d.Get("volume_type").(string),
+		Multiattach:         d.Get("multiattach").(bool),
+		Tags:                tags,
+		EnterpriseProjectID: d.Get("enterprise_project_id").(string),
 	}
 	m := make(map[string]string)
 	if v, ok := d.GetOk("kms_id"); ok {
@@ -229,6 +236,7 @@ func resourceEvsVolumeV3Read(d *schema.ResourceData, meta interface{}) error {
 		d.Set("snapshot_id", v.SnapshotID),
 		d.Set("volume_type", v.VolumeType),
 		d.Set("wwn", v.WWN),
+		d.Set("enterprise_project_id", v.EnterpriseProjectID),
 	)
 	if err := mErr.ErrorOrNil(); err != nil {
 		return err
@@ -280,5 +288,14 @@ func resourceEvsVolumeV3Update(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("tags") {
 		_, err = resourceEVSTagV2Create(d, meta, "volumes", d.Id(), resourceContainerTags(d))
 	}
+
+	migrateOpts := eps.MigrateResourceOpts{
+		ResourceType: "disk",
+		ResourceID:   d.Id(),
+	}
+	if err := eps.MigrateResource(d, config, migrateOpts); err != nil {
+		return err
+	}
+
 	return resourceEvsVolumeV3Read(d, meta)
 }
@@ -0,0 +1,157 @@
+package iam
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+)
+
+// IdentityPolicyDocument represents the IAM policy JSON document.
+type IdentityPolicyDocument struct {
+	Version   string                    `json:"Version"`
+	Statement []IdentityPolicyStatement `json:"Statement"`
+}
+
+// IdentityPolicyStatement represents the statement of the IAM policy.
+type IdentityPolicyStatement struct {
+	Effect    string                         `json:"Effect"`
+	Action    []string                       `json:"Action"`
+	Resource  []string                       `json:"Resource,omitempty"`
+	Condition map[string]map[string][]string `json:"Condition,omitempty"`
+}
+
+func DataSourceIdentityPolicyDocumentV3() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIdentityPolicyDocumentV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "1.1",
+				ValidateFunc: validation.StringInSlice([]string{
+					"1.0", "1.1",
+				}, false),
+			},
+			"statement": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 8,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"effect": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "Allow",
+							ValidateFunc: validation.StringInSlice([]string{
+								"Allow", "Deny",
+							}, false),
+						},
+						"action": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MaxItems: 100,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"resource": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"condition": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"operator": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"key": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"values": {
+										Type:     schema.TypeSet,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// expandSortedStringSet returns sorted set elements to keep the rendered document stable
+func expandSortedStringSet(set *schema.Set) []string {
+	if set.Len() == 0 {
+		return nil
+	}
+	result := common.ExpandToStringSlice(set.List())
+	sort.Strings(result)
+	return result
+}
+
+func expandIdentityPolicyConditions(conditions []interface{}) (map[string]map[string][]string, error) {
+	if len(conditions) == 0 {
+		return nil, nil
+	}
+	result := make(map[string]map[string][]string)
+	for _, v := range conditions {
+		condition := v.(map[string]interface{})
+		operator := condition["operator"].(string)
+		key := condition["key"].(string)
+		if _, ok := result[operator]; !ok {
+			result[operator] = make(map[string][]string)
+		}
+		if _, ok := result[operator][key]; ok {
+			return nil, fmt.Errorf("duplicate condition %s for key %s", operator, key)
+		}
+		result[operator][key] = expandSortedStringSet(condition["values"].(*schema.Set))
+	}
+	return result, nil
+}
+
+func dataSourceIdentityPolicyDocumentV3Read(d *schema.ResourceData, _ interface{}) error {
+	document := IdentityPolicyDocument{
+		Version: d.Get("version").(string),
+	}
+
+	for i, v := range d.Get("statement").([]interface{}) {
+		statement := v.(map[string]interface{})
+		conditions, err := expandIdentityPolicyConditions(statement["condition"].(*schema.Set).List())
+		if err != nil {
+			return fmt.Errorf("error building statement %d: %s", i, err)
+		}
+		document.Statement = append(document.Statement, IdentityPolicyStatement{
+			Effect:    statement["effect"].(string),
+			Action:    expandSortedStringSet(statement["action"].(*schema.Set)),
+			Resource:  expandSortedStringSet(statement["resource"].(*schema.Set)),
+			Condition: conditions,
+		})
+	}
+
+	policy, err := json.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error rendering policy document: %s", err)
+	}
+	jsonString := string(policy)
+
+	d.SetId(strconv.Itoa(hashcode.String(jsonString)))
+	return d.Set("json", jsonString)
+}
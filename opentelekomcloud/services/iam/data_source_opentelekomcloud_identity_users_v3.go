@@ -0,0 +1,163 @@
+package iam
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/identity/v3/users"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func DataSourceIdentityUsersV3() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIdentityUsersV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"domain_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"access_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"default", "programmatic", "console",
+				}, false),
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"users": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"domain_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"default_project_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"access_mode": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"pwd_reset": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"password_expires_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_login_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIdentityUsersV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.IdentityV3Client()
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud identity client: %s", err)
+	}
+
+	listOpts := users.ListOpts{
+		DomainID: d.Get("domain_id").(string),
+		Name:     d.Get("name").(string),
+	}
+	if v, ok := d.GetOkExists("enabled"); ok {
+		enabled := v.(bool)
+		listOpts.Enabled = &enabled
+	}
+	log.Printf("[DEBUG] List Options: %#v", listOpts)
+
+	allUsers, err := listUsers(client, listOpts)
+	if err != nil {
+		return fmt.Errorf("unable to query users: %s", err)
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+	accessMode := d.Get("access_mode").(string)
+
+	var ids []string
+	var result []map[string]interface{}
+	for _, user := range allUsers {
+		if nameRegex != nil && !nameRegex.MatchString(user.Name) {
+			continue
+		}
+		if accessMode != "" && user.AccessMode != accessMode {
+			continue
+		}
+
+		ids = append(ids, user.ID)
+		result = append(result, map[string]interface{}{
+			"id":                  user.ID,
+			"name":                user.Name,
+			"description":         user.Description,
+			"domain_id":           user.DomainID,
+			"default_project_id":  user.DefaultProjectID,
+			"enabled":             user.Enabled,
+			"access_mode":         user.AccessMode,
+			"pwd_reset":           user.PwdStatus,
+			"password_expires_at": user.PasswordExpiresAt,
+			"last_login_time":     user.LastLoginTime,
+		})
+	}
+	log.Printf("[DEBUG] Found %d users", len(result))
+
+	d.SetId(hashcode.Strings(ids))
+	mErr := multierror.Append(nil,
+		d.Set("ids", ids),
+		d.Set("users", result),
+	)
+	return mErr.ErrorOrNil()
+}
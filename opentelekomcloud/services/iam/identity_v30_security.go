@@ -0,0 +1,145 @@
+package iam
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// PasswordPolicy represents the password policy of the domain.
+type PasswordPolicy struct {
+	MaximumConsecutiveIdenticalChars  int  `json:"maximum_consecutive_identical_chars"`
+	MinimumPasswordAge                int  `json:"minimum_password_age"`
+	MinimumPasswordLength             int  `json:"minimum_password_length"`
+	NumberOfRecentPasswordsDisallowed int  `json:"number_of_recent_passwords_disallowed"`
+	PasswordNotUsernameOrInvert       bool `json:"password_not_username_or_invert"`
+	PasswordValidityPeriod            int  `json:"password_validity_period"`
+	PasswordCharCombination           int  `json:"password_char_combination,omitempty"`
+}
+
+// LoginPolicy represents the login authentication policy of the domain.
+type LoginPolicy struct {
+	AccountValidityPeriod   int    `json:"account_validity_period"`
+	CustomInfoForLogin      string `json:"custom_info_for_login"`
+	LockoutDuration         int    `json:"lockout_duration"`
+	LoginFailedTimes        int    `json:"login_failed_times"`
+	PeriodWithLoginFailures int    `json:"period_with_login_failures"`
+	SessionTimeout          int    `json:"session_timeout"`
+	ShowRecentLoginInfo     bool   `json:"show_recent_login_info"`
+}
+
+// LoginProtection represents the login protection settings of the user.
+type LoginProtection struct {
+	Enabled            bool   `json:"enabled"`
+	VerificationMethod string `json:"verification_method"`
+}
+
+// ACLPolicy represents the console or API access control policy of the domain.
+// `AllowVPCEndpoints` is supported by the API ACL only.
+type ACLPolicy struct {
+	AllowAddressNetmasks []AllowAddressNetmask `json:"allow_address_netmasks"`
+	AllowIPRanges        []AllowIPRange        `json:"allow_ip_ranges"`
+	AllowVPCEndpoints    []AllowVPCEndpoint    `json:"allow_vpc_endpoints,omitempty"`
+}
+
+// AllowAddressNetmask is the IPv4 CIDR allowed by the ACL policy.
+type AllowAddressNetmask struct {
+	AddressNetmask string `json:"address_netmask"`
+	Description    string `json:"description"`
+}
+
+// AllowIPRange is the IPv4 address range allowed by the ACL policy.
+type AllowIPRange struct {
+	IPRange     string `json:"ip_range"`
+	Description string `json:"description"`
+}
+
+// AllowVPCEndpoint is the VPC endpoint allowed by the API ACL policy.
+type AllowVPCEndpoint struct {
+	VPCEndpointID string `json:"vpc_endpoint_id"`
+	Description   string `json:"description"`
+}
+
+func securityPolicyURL(client *golangsdk.ServiceClient, domainID, policy string) string {
+	return client.ServiceURL("OS-SECURITYPOLICY", "domains", domainID, policy)
+}
+
+func getPasswordPolicy(client *golangsdk.ServiceClient, domainID string) (*PasswordPolicy, error) {
+	var res struct {
+		Policy PasswordPolicy `json:"password_policy"`
+	}
+	if _, err := client.Get(securityPolicyURL(client, domainID, "password-policy"), &res, identityRequestOpts(200)); err != nil {
+		return nil, err
+	}
+	return &res.Policy, nil
+}
+
+func updatePasswordPolicy(client *golangsdk.ServiceClient, domainID string, policy PasswordPolicy) error {
+	b := map[string]interface{}{
+		"password_policy": policy,
+	}
+	_, err := client.Put(securityPolicyURL(client, domainID, "password-policy"), b, nil, identityRequestOpts(200))
+	return err
+}
+
+func getLoginPolicy(client *golangsdk.ServiceClient, domainID string) (*LoginPolicy, error) {
+	var res struct {
+		Policy LoginPolicy `json:"login_policy"`
+	}
+	if _, err := client.Get(securityPolicyURL(client, domainID, "login-policy"), &res, identityRequestOpts(200)); err != nil {
+		return nil, err
+	}
+	return &res.Policy, nil
+}
+
+func updateLoginPolicy(client *golangsdk.ServiceClient, domainID string, policy LoginPolicy) error {
+	b := map[string]interface{}{
+		"login_policy": policy,
+	}
+	_, err := client.Put(securityPolicyURL(client, domainID, "login-policy"), b, nil, identityRequestOpts(200))
+	return err
+}
+
+// aclPolicyName returns API resource name and body key of the ACL of the given type (`console` or `api`)
+func aclPolicyName(aclType string) (string, string) {
+	return aclType + "-acl-policy", aclType + "_acl_policy"
+}
+
+func getACLPolicy(client *golangsdk.ServiceClient, domainID, aclType string) (*ACLPolicy, error) {
+	path, key := aclPolicyName(aclType)
+	var res map[string]ACLPolicy
+	if _, err := client.Get(securityPolicyURL(client, domainID, path), &res, identityRequestOpts(200)); err != nil {
+		return nil, err
+	}
+	policy := res[key]
+	return &policy, nil
+}
+
+func updateACLPolicy(client *golangsdk.ServiceClient, domainID, aclType string, policy ACLPolicy) error {
+	path, key := aclPolicyName(aclType)
+	b := map[string]interface{}{
+		key: policy,
+	}
+	_, err := client.Put(securityPolicyURL(client, domainID, path), b, nil, identityRequestOpts(200))
+	return err
+}
+
+func loginProtectionURL(client *golangsdk.ServiceClient, userID string) string {
+	return client.ServiceURL("OS-USER", "users", userID, "login-protect")
+}
+
+func getLoginProtection(client *golangsdk.ServiceClient, userID string) (*LoginProtection, error) {
+	var res struct {
+		LoginProtect LoginProtection `json:"login_protect"`
+	}
+	if _, err := client.Get(loginProtectionURL(client, userID), &res, identityRequestOpts(200)); err != nil {
+		return nil, err
+	}
+	return &res.LoginProtect, nil
+}
+
+func updateLoginProtection(client *golangsdk.ServiceClient, userID string, protection LoginProtection) error {
+	b := map[string]interface{}{
+		"login_protect": protection,
+	}
+	_, err := client.Put(loginProtectionURL(client, userID), b, nil, identityRequestOpts(200, 204))
+	return err
+}
@@ -0,0 +1,83 @@
+package iam
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/identity/v3/users"
+)
+
+// UserV30 represents the IAM user with the extended attributes available in v3.0 API.
+type UserV30 struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	DomainID      string `json:"domain_id"`
+	Enabled       bool   `json:"enabled"`
+	Description   string `json:"description"`
+	Email         string `json:"email"`
+	AreaCode      string `json:"areacode"`
+	Phone         string `json:"phone"`
+	AccessMode    string `json:"access_mode"`
+	PwdStatus     bool   `json:"pwd_status"`
+	LastLoginTime string `json:"last_login_time"`
+}
+
+// UserListItem represents the IAM user in the v3 list response, which contains the extended attributes as well.
+type UserListItem struct {
+	UserV30
+	DefaultProjectID  string `json:"default_project_id"`
+	PasswordExpiresAt string `json:"password_expires_at"`
+}
+
+// UserV30UpdateOpts contains the extended values used for the user update.
+type UserV30UpdateOpts struct {
+	Description *string `json:"description,omitempty"`
+	Email       *string `json:"email,omitempty"`
+	AreaCode    *string `json:"areacode,omitempty"`
+	Phone       *string `json:"phone,omitempty"`
+	AccessMode  string  `json:"access_mode,omitempty"`
+	PwdStatus   *bool   `json:"pwd_status,omitempty"`
+}
+
+func userV30URL(client *golangsdk.ServiceClient, userID string) string {
+	return client.ServiceURL("OS-USER", "users", userID)
+}
+
+func getUserV30(client *golangsdk.ServiceClient, userID string) (*UserV30, error) {
+	var res struct {
+		User UserV30 `json:"user"`
+	}
+	if _, err := client.Get(userV30URL(client, userID), &res, identityRequestOpts(200)); err != nil {
+		return nil, err
+	}
+	return &res.User, nil
+}
+
+// listUsers returns users with the extended attributes, `client` must be v3 client
+func listUsers(client *golangsdk.ServiceClient, opts users.ListOpts) ([]UserListItem, error) {
+	query, err := opts.ToUserListQuery()
+	if err != nil {
+		return nil, err
+	}
+	var res struct {
+		Users []UserListItem `json:"users"`
+	}
+	if _, err := client.Get(client.ServiceURL("users")+query, &res, identityRequestOpts(200)); err != nil {
+		return nil, err
+	}
+	return res.Users, nil
+}
+
+func updateUserV30(client *golangsdk.ServiceClient, userID string, opts UserV30UpdateOpts) error {
+	b, err := golangsdk.BuildRequestBody(opts, "user")
+	if err != nil {
+		return err
+	}
+	_, err = client.Put(userV30URL(client, userID), b, nil, identityRequestOpts(200))
+	return err
+}
+
+// sendWelcomeEmail sends the email with the login link to the user, the user must have an email set
+func sendWelcomeEmail(client *golangsdk.ServiceClient, userID string) error {
+	url := client.ServiceURL("OS-USER", "users", userID, "actions", "send-welcome-email")
+	_, err := client.Post(url, nil, nil, identityRequestOpts(200, 202, 204))
+	return err
+}
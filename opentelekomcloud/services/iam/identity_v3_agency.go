@@ -0,0 +1,29 @@
+package iam
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// AgencyCreateOpts extends `agency.CreateOpts` with the validity period.
+type AgencyCreateOpts struct {
+	Name            string `json:"name" required:"true"`
+	DomainID        string `json:"domain_id" required:"true"`
+	DelegatedDomain string `json:"trust_domain_name" required:"true"`
+	Description     string `json:"description,omitempty"`
+	Duration        string `json:"duration,omitempty"`
+}
+
+func (opts AgencyCreateOpts) ToAgencyCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "agency")
+}
+
+// AgencyUpdateOpts extends `agency.UpdateOpts` with the validity period.
+type AgencyUpdateOpts struct {
+	DelegatedDomain string `json:"trust_domain_name,omitempty"`
+	Description     string `json:"description,omitempty"`
+	Duration        string `json:"duration,omitempty"`
+}
+
+func (opts AgencyUpdateOpts) ToAgencyUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "agency")
+}
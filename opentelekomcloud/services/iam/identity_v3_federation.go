@@ -0,0 +1,242 @@
+package iam
+
+import (
+	"regexp"
+	"strings"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+var identityProviderNameRegexp = regexp.MustCompile(`^[\w-]+$`)
+
+// IdentityProvider represents the federation identity provider.
+type IdentityProvider struct {
+	ID          string   `json:"id"`
+	Description string   `json:"description"`
+	Enabled     bool     `json:"enabled"`
+	RemoteIDs   []string `json:"remote_ids"`
+}
+
+// IdentityProviderOpts contains the values used for the identity provider creation and update.
+type IdentityProviderOpts struct {
+	Description string `json:"description"`
+	Enabled     bool   `json:"enabled"`
+}
+
+// IdentityMapping represents the federation mapping.
+type IdentityMapping struct {
+	ID    string        `json:"id"`
+	Rules []interface{} `json:"rules"`
+}
+
+// IdentityProtocol represents the federation protocol of the identity provider.
+type IdentityProtocol struct {
+	ID        string `json:"id"`
+	MappingID string `json:"mapping_id"`
+}
+
+// SAMLMetadataOpts contains the values used for the SAML metadata upload.
+type SAMLMetadataOpts struct {
+	DomainID     string `json:"domain_id"`
+	XAccountType string `json:"xaccount_type"`
+	Metadata     string `json:"metadata"`
+}
+
+// SAMLMetadata represents the SAML metadata of the identity provider.
+type SAMLMetadata struct {
+	ID       string `json:"id"`
+	EntityID string `json:"entity_id"`
+	Data     string `json:"data"`
+}
+
+// OIDCConfig represents the OpenID Connect configuration of the identity provider.
+type OIDCConfig struct {
+	AccessMode            string `json:"access_mode"`
+	IdpURL                string `json:"idp_url"`
+	ClientID              string `json:"client_id"`
+	AuthorizationEndpoint string `json:"authorization_endpoint,omitempty"`
+	Scope                 string `json:"scope,omitempty"`
+	ResponseType          string `json:"response_type,omitempty"`
+	ResponseMode          string `json:"response_mode,omitempty"`
+	SigningKey            string `json:"signing_key"`
+}
+
+func identityRequestOpts(codes ...int) *golangsdk.RequestOpts {
+	return &golangsdk.RequestOpts{
+		OkCodes: codes,
+	}
+}
+
+func identityProviderURL(client *golangsdk.ServiceClient, id string) string {
+	return client.ServiceURL("OS-FEDERATION", "identity_providers", id)
+}
+
+func createIdentityProvider(client *golangsdk.ServiceClient, id string, opts IdentityProviderOpts) error {
+	b := map[string]interface{}{
+		"identity_provider": opts,
+	}
+	_, err := client.Put(identityProviderURL(client, id), b, nil, identityRequestOpts(200, 201))
+	return err
+}
+
+func getIdentityProvider(client *golangsdk.ServiceClient, id string) (*IdentityProvider, error) {
+	var res struct {
+		IdentityProvider IdentityProvider `json:"identity_provider"`
+	}
+	if _, err := client.Get(identityProviderURL(client, id), &res, identityRequestOpts(200)); err != nil {
+		return nil, err
+	}
+	return &res.IdentityProvider, nil
+}
+
+func updateIdentityProvider(client *golangsdk.ServiceClient, id string, opts IdentityProviderOpts) error {
+	b := map[string]interface{}{
+		"identity_provider": opts,
+	}
+	_, err := client.Patch(identityProviderURL(client, id), b, nil, identityRequestOpts(200))
+	return err
+}
+
+func deleteIdentityProvider(client *golangsdk.ServiceClient, id string) error {
+	_, err := client.Delete(identityProviderURL(client, id), identityRequestOpts(204))
+	return err
+}
+
+// samlMetadataURL returns URL of the metadata API which is available in `v3-ext` only
+func samlMetadataURL(client *golangsdk.ServiceClient, providerID string) string {
+	base := strings.Replace(client.ResourceBaseURL(), "/v3/", "/v3-ext/", 1)
+	return base + strings.Join([]string{"OS-FEDERATION", "identity_providers", providerID, "protocols", "saml", "metadata"}, "/")
+}
+
+func uploadSAMLMetadata(client *golangsdk.ServiceClient, providerID string, opts SAMLMetadataOpts) error {
+	_, err := client.Post(samlMetadataURL(client, providerID), opts, nil, identityRequestOpts(200, 201))
+	return err
+}
+
+func getSAMLMetadata(client *golangsdk.ServiceClient, providerID string) (*SAMLMetadata, error) {
+	var res SAMLMetadata
+	if _, err := client.Get(samlMetadataURL(client, providerID), &res, identityRequestOpts(200)); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// oidcConfigURL returns URL of the OpenID Connect configuration, `client` must be v3.0 client
+func oidcConfigURL(client *golangsdk.ServiceClient, providerID string) string {
+	return client.ServiceURL("OS-FEDERATION", "identity-providers", providerID, "openid-connect-config")
+}
+
+func createOIDCConfig(client *golangsdk.ServiceClient, providerID string, config OIDCConfig) error {
+	b := map[string]interface{}{
+		"openid_connect_config": config,
+	}
+	_, err := client.Post(oidcConfigURL(client, providerID), b, nil, identityRequestOpts(200, 201))
+	return err
+}
+
+func updateOIDCConfig(client *golangsdk.ServiceClient, providerID string, config OIDCConfig) error {
+	b := map[string]interface{}{
+		"openid_connect_config": config,
+	}
+	_, err := client.Put(oidcConfigURL(client, providerID), b, nil, identityRequestOpts(200))
+	return err
+}
+
+func getOIDCConfig(client *golangsdk.ServiceClient, providerID string) (*OIDCConfig, error) {
+	var res struct {
+		Config OIDCConfig `json:"openid_connect_config"`
+	}
+	if _, err := client.Get(oidcConfigURL(client, providerID), &res, identityRequestOpts(200)); err != nil {
+		return nil, err
+	}
+	return &res.Config, nil
+}
+
+func identityMappingURL(client *golangsdk.ServiceClient, id string) string {
+	return client.ServiceURL("OS-FEDERATION", "mappings", id)
+}
+
+func createIdentityMapping(client *golangsdk.ServiceClient, id string, rules []interface{}) error {
+	b := map[string]interface{}{
+		"mapping": map[string]interface{}{
+			"rules": rules,
+		},
+	}
+	_, err := client.Put(identityMappingURL(client, id), b, nil, identityRequestOpts(200, 201))
+	return err
+}
+
+func getIdentityMapping(client *golangsdk.ServiceClient, id string) (*IdentityMapping, error) {
+	var res struct {
+		Mapping IdentityMapping `json:"mapping"`
+	}
+	if _, err := client.Get(identityMappingURL(client, id), &res, identityRequestOpts(200)); err != nil {
+		return nil, err
+	}
+	return &res.Mapping, nil
+}
+
+func updateIdentityMapping(client *golangsdk.ServiceClient, id string, rules []interface{}) error {
+	b := map[string]interface{}{
+		"mapping": map[string]interface{}{
+			"rules": rules,
+		},
+	}
+	_, err := client.Patch(identityMappingURL(client, id), b, nil, identityRequestOpts(200))
+	return err
+}
+
+func deleteIdentityMapping(client *golangsdk.ServiceClient, id string) error {
+	_, err := client.Delete(identityMappingURL(client, id), identityRequestOpts(204))
+	return err
+}
+
+func identityProtocolURL(client *golangsdk.ServiceClient, providerID, protocol string) string {
+	return client.ServiceURL("OS-FEDERATION", "identity_providers", providerID, "protocols", protocol)
+}
+
+func createIdentityProtocol(client *golangsdk.ServiceClient, providerID, protocol, mappingID string) error {
+	b := map[string]interface{}{
+		"protocol": map[string]string{
+			"mapping_id": mappingID,
+		},
+	}
+	_, err := client.Put(identityProtocolURL(client, providerID, protocol), b, nil, identityRequestOpts(200, 201))
+	return err
+}
+
+func getIdentityProtocol(client *golangsdk.ServiceClient, providerID, protocol string) (*IdentityProtocol, error) {
+	var res struct {
+		Protocol IdentityProtocol `json:"protocol"`
+	}
+	if _, err := client.Get(identityProtocolURL(client, providerID, protocol), &res, identityRequestOpts(200)); err != nil {
+		return nil, err
+	}
+	return &res.Protocol, nil
+}
+
+func listIdentityProtocols(client *golangsdk.ServiceClient, providerID string) ([]IdentityProtocol, error) {
+	var res struct {
+		Protocols []IdentityProtocol `json:"protocols"`
+	}
+	u := client.ServiceURL("OS-FEDERATION", "identity_providers", providerID, "protocols")
+	if _, err := client.Get(u, &res, identityRequestOpts(200)); err != nil {
+		return nil, err
+	}
+	return res.Protocols, nil
+}
+
+func updateIdentityProtocol(client *golangsdk.ServiceClient, providerID, protocol, mappingID string) error {
+	b := map[string]interface{}{
+		"protocol": map[string]string{
+			"mapping_id": mappingID,
+		},
+	}
+	_, err := client.Patch(identityProtocolURL(client, providerID, protocol), b, nil, identityRequestOpts(200))
+	return err
+}
+
+func deleteIdentityProtocol(client *golangsdk.ServiceClient, providerID, protocol string) error {
+	_, err := client.Delete(identityProtocolURL(client, providerID, protocol), identityRequestOpts(204))
+	return err
+}
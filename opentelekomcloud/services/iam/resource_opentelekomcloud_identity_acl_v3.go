@@ -0,0 +1,274 @@
+package iam
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+// allowAllIPRange is the ACL range set on the resource deletion, it allows access from any address
+const allowAllIPRange = "0.0.0.0-255.255.255.255"
+
+func ResourceIdentityACLV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIdentityACLV3Update,
+		Read:   resourceIdentityACLV3Read,
+		Update: resourceIdentityACLV3Update,
+		Delete: resourceIdentityACLV3Delete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceIdentityACLV3Import,
+		},
+
+		CustomizeDiff: validateIdentityACLV3VPCEndpoints,
+
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"console", "api",
+				}, false),
+			},
+			"ip_cidrs": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				MaxItems:     200,
+				AtLeastOneOf: []string{"ip_cidrs", "ip_ranges", "vpc_endpoints"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cidr": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsCIDR,
+						},
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 255),
+						},
+					},
+				},
+			},
+			"ip_ranges": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 200,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"range": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateIPRange,
+						},
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 255),
+						},
+					},
+				},
+			},
+			"vpc_endpoints": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 200,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 255),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func validateIPRange(v interface{}, k string) (ws []string, errors []error) {
+	parts := strings.Split(v.(string), "-")
+	if len(parts) != 2 {
+		errors = append(errors, fmt.Errorf("%q must be a range of IPv4 addresses in `<start>-<end>` format, got: %s", k, v))
+		return
+	}
+	for _, part := range parts {
+		w, es := validation.IsIPv4Address(part, k)
+		ws = append(ws, w...)
+		errors = append(errors, es...)
+	}
+	return
+}
+
+func validateIdentityACLV3VPCEndpoints(d *schema.ResourceDiff, _ interface{}) error {
+	if d.Get("type").(string) != "api" && d.Get("vpc_endpoints").(*schema.Set).Len() > 0 {
+		return fmt.Errorf("`vpc_endpoints` can be set for `api` ACL only")
+	}
+	return nil
+}
+
+func parseIdentityACLV3ID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid ID format, expected <domain_id>/<type>: %s", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func expandIdentityACLV3(d *schema.ResourceData) ACLPolicy {
+	policy := ACLPolicy{
+		AllowAddressNetmasks: []AllowAddressNetmask{},
+		AllowIPRanges:        []AllowIPRange{},
+	}
+	for _, v := range d.Get("ip_cidrs").(*schema.Set).List() {
+		cidr := v.(map[string]interface{})
+		policy.AllowAddressNetmasks = append(policy.AllowAddressNetmasks, AllowAddressNetmask{
+			AddressNetmask: cidr["cidr"].(string),
+			Description:    cidr["description"].(string),
+		})
+	}
+	for _, v := range d.Get("ip_ranges").(*schema.Set).List() {
+		ipRange := v.(map[string]interface{})
+		policy.AllowIPRanges = append(policy.AllowIPRanges, AllowIPRange{
+			IPRange:     ipRange["range"].(string),
+			Description: ipRange["description"].(string),
+		})
+	}
+	if d.Get("type").(string) == "api" {
+		policy.AllowVPCEndpoints = []AllowVPCEndpoint{}
+		for _, v := range d.Get("vpc_endpoints").(*schema.Set).List() {
+			endpoint := v.(map[string]interface{})
+			policy.AllowVPCEndpoints = append(policy.AllowVPCEndpoints, AllowVPCEndpoint{
+				VPCEndpointID: endpoint["id"].(string),
+				Description:   endpoint["description"].(string),
+			})
+		}
+	}
+	return policy
+}
+
+func resourceIdentityACLV3Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.IdentityV30Client()
+	if err != nil {
+		return fmt.Errorf("error creating identity v3.0 client: %s", err)
+	}
+
+	domainID, err := getDomainID(config, client)
+	if err != nil {
+		return fmt.Errorf("error getting domain ID: %s", err)
+	}
+
+	aclType := d.Get("type").(string)
+	policy := expandIdentityACLV3(d)
+	log.Printf("[DEBUG] Updating %s ACL of domain %s: %#v", aclType, domainID, policy)
+	if err := updateACLPolicy(client, domainID, aclType, policy); err != nil {
+		return fmt.Errorf("error updating %s ACL: %s", aclType, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", domainID, aclType))
+
+	return resourceIdentityACLV3Read(d, meta)
+}
+
+func resourceIdentityACLV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.IdentityV30Client()
+	if err != nil {
+		return fmt.Errorf("error creating identity v3.0 client: %s", err)
+	}
+
+	domainID, aclType, err := parseIdentityACLV3ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	policy, err := getACLPolicy(client, domainID, aclType)
+	if err != nil {
+		return fmt.Errorf("error reading %s ACL: %s", aclType, err)
+	}
+	log.Printf("[DEBUG] Retrieved %s ACL: %#v", aclType, policy)
+
+	cidrs := make([]map[string]interface{}, 0, len(policy.AllowAddressNetmasks))
+	for _, v := range policy.AllowAddressNetmasks {
+		cidrs = append(cidrs, map[string]interface{}{
+			"cidr":        v.AddressNetmask,
+			"description": v.Description,
+		})
+	}
+	ipRanges := make([]map[string]interface{}, 0, len(policy.AllowIPRanges))
+	for _, v := range policy.AllowIPRanges {
+		ipRanges = append(ipRanges, map[string]interface{}{
+			"range":       v.IPRange,
+			"description": v.Description,
+		})
+	}
+
+	endpoints := make([]map[string]interface{}, 0, len(policy.AllowVPCEndpoints))
+	for _, v := range policy.AllowVPCEndpoints {
+		endpoints = append(endpoints, map[string]interface{}{
+			"id":          v.VPCEndpointID,
+			"description": v.Description,
+		})
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("type", aclType),
+		d.Set("ip_cidrs", cidrs),
+		d.Set("ip_ranges", ipRanges),
+		d.Set("vpc_endpoints", endpoints),
+	)
+	return mErr.ErrorOrNil()
+}
+
+func resourceIdentityACLV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.IdentityV30Client()
+	if err != nil {
+		return fmt.Errorf("error creating identity v3.0 client: %s", err)
+	}
+
+	domainID, aclType, err := parseIdentityACLV3ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	policy := ACLPolicy{
+		AllowAddressNetmasks: []AllowAddressNetmask{},
+		AllowIPRanges: []AllowIPRange{
+			{IPRange: allowAllIPRange},
+		},
+	}
+	if aclType == "api" {
+		policy.AllowVPCEndpoints = []AllowVPCEndpoint{}
+	}
+	if err := updateACLPolicy(client, domainID, aclType, policy); err != nil {
+		return fmt.Errorf("error resetting %s ACL: %s", aclType, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceIdentityACLV3Import(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	_, aclType, err := parseIdentityACLV3ID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Set("type", aclType); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,162 @@
+package iam
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/identity/v3/agency"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/identity/v3/roles"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceIdentityAgencyRoleAssignmentV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIdentityAgencyRoleAssignmentV3Create,
+		Read:   resourceIdentityAgencyRoleAssignmentV3Read,
+		Delete: resourceIdentityAgencyRoleAssignmentV3Delete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"agency_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"role_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"project_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"project_id", "domain_id"},
+			},
+			"domain_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"project_id", "domain_id"},
+			},
+		},
+	}
+}
+
+// Agency role assignments have no ID, build it out of the IDs making up the assignment
+func buildAgencyRoleAssignmentID(agencyID, domainID, projectID, roleID string) string {
+	return fmt.Sprintf("%s/%s/%s/%s", agencyID, domainID, projectID, roleID)
+}
+
+func parseAgencyRoleAssignmentID(id string) (agencyID, domainID, projectID, roleID string, err error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 4 || parts[0] == "" || parts[3] == "" || (parts[1] == "") == (parts[2] == "") {
+		err = fmt.Errorf("invalid ID format, expected <agency_id>/<domain_id>/<project_id>/<role_id> "+
+			"with exactly one of domain and project IDs set: %s", id)
+		return
+	}
+	return parts[0], parts[1], parts[2], parts[3], nil
+}
+
+func resourceIdentityAgencyRoleAssignmentV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := agencyClient(d, config)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud identity client: %s", err)
+	}
+
+	agencyID := d.Get("agency_id").(string)
+	domainID := d.Get("domain_id").(string)
+	projectID := d.Get("project_id").(string)
+	roleID := d.Get("role_id").(string)
+
+	if projectID != "" {
+		err = agency.AttachRoleByProject(client, agencyID, projectID, roleID).ExtractErr()
+	} else {
+		err = agency.AttachRoleByDomain(client, agencyID, domainID, roleID).ExtractErr()
+	}
+	if err != nil {
+		return fmt.Errorf("error attaching role %s to agency %s: %s", roleID, agencyID, err)
+	}
+
+	d.SetId(buildAgencyRoleAssignmentID(agencyID, domainID, projectID, roleID))
+
+	return resourceIdentityAgencyRoleAssignmentV3Read(d, meta)
+}
+
+func resourceIdentityAgencyRoleAssignmentV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := agencyClient(d, config)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud identity client: %s", err)
+	}
+
+	agencyID, domainID, projectID, roleID, err := parseAgencyRoleAssignmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	var attached []roles.Role
+	if projectID != "" {
+		attached, err = agency.ListRolesAttachedOnProject(client, agencyID, projectID).ExtractRoles()
+	} else {
+		attached, err = agency.ListRolesAttachedOnDomain(client, agencyID, domainID).ExtractRoles()
+	}
+	if err != nil {
+		return common.CheckDeleted(d, err, "agency role assignment")
+	}
+
+	found := false
+	for _, role := range attached {
+		if role.ID == roleID {
+			found = true
+			break
+		}
+	}
+	if !found {
+		log.Printf("[WARN] Role %s is not attached to agency %s, removing from state", roleID, agencyID)
+		d.SetId("")
+		return nil
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("agency_id", agencyID),
+		d.Set("domain_id", domainID),
+		d.Set("project_id", projectID),
+		d.Set("role_id", roleID),
+	)
+	return mErr.ErrorOrNil()
+}
+
+func resourceIdentityAgencyRoleAssignmentV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := agencyClient(d, config)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud identity client: %s", err)
+	}
+
+	agencyID, domainID, projectID, roleID, err := parseAgencyRoleAssignmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if projectID != "" {
+		err = agency.DetachRoleByProject(client, agencyID, projectID, roleID).ExtractErr()
+	} else {
+		err = agency.DetachRoleByDomain(client, agencyID, domainID, roleID).ExtractErr()
+	}
+	if err != nil {
+		return common.CheckDeleted(d, err, "agency role assignment")
+	}
+
+	d.SetId("")
+	return nil
+}
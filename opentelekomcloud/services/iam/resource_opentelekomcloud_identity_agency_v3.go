@@ -4,12 +4,14 @@ import (
 	"bytes"
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/opentelekomcloud/gophertelekomcloud"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/identity/v3/agency"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/identity/v3/domains"
@@ -27,7 +29,7 @@ func ResourceIdentityAgencyV3() *schema.Resource {
 		Update: resourceIdentityAgencyV3Update,
 		Delete: resourceIdentityAgencyV3Delete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceIdentityAgencyV3Import,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -43,8 +45,16 @@ func ResourceIdentityAgencyV3() *schema.Resource {
 				ForceNew: true,
 			},
 			"delegated_domain_name": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"delegated_domain_name", "delegated_service_name"},
+			},
+			"delegated_service_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"delegated_domain_name", "delegated_service_name"},
+				ValidateFunc: validation.StringMatch(agencyServiceNameRegexp,
+					"service name must start with `op_svc_`"),
 			},
 			"description": {
 				Type:     schema.TypeString,
@@ -53,7 +63,11 @@ func ResourceIdentityAgencyV3() *schema.Resource {
 			},
 			"duration": {
 				Type:     schema.TypeString,
+				Optional: true,
 				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"FOREVER", "ONEDAY",
+				}, false),
 			},
 			"expire_time": {
 				Type:     schema.TypeString,
@@ -96,6 +110,17 @@ func ResourceIdentityAgencyV3() *schema.Resource {
 	}
 }
 
+// agencyServiceNameRegexp matches names of the cloud services, e.g. `op_svc_evs`
+var agencyServiceNameRegexp = regexp.MustCompile(`^op_svc_\w+$`)
+
+// agencyTrustTarget returns the delegated account or cloud service name
+func agencyTrustTarget(d *schema.ResourceData) string {
+	if v, ok := d.GetOk("delegated_service_name"); ok {
+		return v.(string)
+	}
+	return d.Get("delegated_domain_name").(string)
+}
+
 func resourceIdentityAgencyProRoleHash(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
@@ -290,9 +315,6 @@ func diffChangeOfProjectRole(old, newv *schema.Set) (delete, add []string) {
 func resourceIdentityAgencyV3Create(d *schema.ResourceData, meta interface{}) error {
 	prs := d.Get("project_role").(*schema.Set)
 	drs := d.Get("domain_roles").(*schema.Set)
-	if prs.Len() == 0 && drs.Len() == 0 {
-		return fmt.Errorf("One or both of project_role and domain_roles must be input")
-	}
 
 	config := meta.(*cfg.Config)
 	client, err := agencyClient(d, config)
@@ -305,11 +327,12 @@ func resourceIdentityAgencyV3Create(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("Error getting the domain id, err=%s", err)
 	}
 
-	opts := agency.CreateOpts{
+	opts := AgencyCreateOpts{
 		Name:            d.Get("name").(string),
 		DomainID:        domainID,
-		DelegatedDomain: d.Get("delegated_domain_name").(string),
+		DelegatedDomain: agencyTrustTarget(d),
 		Description:     d.Get("description").(string),
+		Duration:        d.Get("duration").(string),
 	}
 	log.Printf("[DEBUG] Create Identity-Agency Options: %#v", opts)
 	a, err := agency.Create(client, opts).Extract()
@@ -385,29 +408,71 @@ func resourceIdentityAgencyV3Read(d *schema.ResourceData, meta interface{}) erro
 	log.Printf("[DEBUG] Retrieved Identity-Agency %s: %#v", d.Id(), a)
 
 	d.Set("name", a.Name)
-	d.Set("delegated_domain_name", a.DelegatedDomainName)
+	// keep the configured field, service agencies created with `delegated_domain_name` are still valid
+	_, isService := d.GetOk("delegated_service_name")
+	if _, isDomain := d.GetOk("delegated_domain_name"); !isService && !isDomain {
+		isService = agencyServiceNameRegexp.MatchString(a.DelegatedDomainName)
+	}
+	if isService {
+		d.Set("delegated_service_name", a.DelegatedDomainName)
+		d.Set("delegated_domain_name", "")
+	} else {
+		d.Set("delegated_domain_name", a.DelegatedDomainName)
+		d.Set("delegated_service_name", "")
+	}
 	d.Set("description", a.Description)
 	d.Set("duration", a.Duration)
 	d.Set("expire_time", a.ExpireTime)
 	d.Set("create_time", a.CreateTime)
 
-	projects, err := listProjectsOfDomain(a.DomainID, client)
+	// only roles managed by the agency resource are read, as roles can be
+	// attached by opentelekomcloud_identity_agency_role_assignment_v3 as well
+	if d.Get("project_role").(*schema.Set).Len() > 0 {
+		if err := readIdentityAgencyV3ProjectRoles(d, client, a.DomainID, false); err != nil {
+			return err
+		}
+	}
+	if d.Get("domain_roles").(*schema.Set).Len() > 0 {
+		if err := readIdentityAgencyV3DomainRoles(d, client, a.DomainID, false); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// readIdentityAgencyV3ProjectRoles sets roles attached to the agency in projects,
+// only roles present in the state are set unless `all` is true
+func readIdentityAgencyV3ProjectRoles(d *schema.ResourceData, client *golangsdk.ServiceClient, domainID string, all bool) error {
+	projects, err := listProjectsOfDomain(domainID, client)
 	if err != nil {
 		return fmt.Errorf("Error querying the projects, err=%s", err)
 	}
+	managed := make(map[string]*schema.Set)
+	for _, v := range d.Get("project_role").(*schema.Set).List() {
+		pr := v.(map[string]interface{})
+		managed[pr["project"].(string)] = pr["roles"].(*schema.Set)
+	}
+
 	agencyID := d.Id()
 	prs := schema.Set{F: resourceIdentityAgencyProRoleHash}
 	for pn, pid := range projects {
+		managedRoles, ok := managed[pn]
+		if !all && !ok {
+			continue
+		}
 		roles, err := agency.ListRolesAttachedOnProject(client, agencyID, pid).ExtractRoles()
 		if err != nil && !common.IsResourceNotFound(err) {
 			return fmt.Errorf("Error querying the roles attached on project(%s), err=%s", pn, err)
 		}
-		if len(roles) == 0 {
-			continue
-		}
 		v := schema.Set{F: schema.HashString}
 		for _, role := range roles {
-			v.Add(role.Extra["display_name"])
+			if all || managedRoles.Contains(role.Extra["display_name"]) {
+				v.Add(role.Extra["display_name"])
+			}
+		}
+		if v.Len() == 0 {
+			continue
 		}
 		prs.Add(map[string]interface{}{
 			"project": pn,
@@ -418,25 +483,50 @@ func resourceIdentityAgencyV3Read(d *schema.ResourceData, meta interface{}) erro
 	if err != nil {
 		log.Printf("[ERROR]Set project_role failed, err=%s", err)
 	}
+	return nil
+}
 
-	roles, err := agency.ListRolesAttachedOnDomain(client, agencyID, a.DomainID).ExtractRoles()
+// readIdentityAgencyV3DomainRoles sets roles attached to the agency in the domain,
+// only roles present in the state are set unless `all` is true
+func readIdentityAgencyV3DomainRoles(d *schema.ResourceData, client *golangsdk.ServiceClient, domainID string, all bool) error {
+	roles, err := agency.ListRolesAttachedOnDomain(client, d.Id(), domainID).ExtractRoles()
 	if err != nil && !common.IsResourceNotFound(err) {
 		return fmt.Errorf("Error querying the roles attached on domain, err=%s", err)
 	}
-	if len(roles) != 0 {
-		v := schema.Set{F: schema.HashString}
-		for _, role := range roles {
+	managed := d.Get("domain_roles").(*schema.Set)
+	v := schema.Set{F: schema.HashString}
+	for _, role := range roles {
+		if all || managed.Contains(role.Extra["display_name"]) {
 			v.Add(role.Extra["display_name"])
 		}
-		err = d.Set("domain_roles", &v)
-		if err != nil {
-			log.Printf("[ERROR]Set domain_roles failed, err=%s", err)
-		}
 	}
-
+	err = d.Set("domain_roles", &v)
+	if err != nil {
+		log.Printf("[ERROR]Set domain_roles failed, err=%s", err)
+	}
 	return nil
 }
 
+// resourceIdentityAgencyV3Import sets all roles attached to the agency, as the state has no managed roles yet
+func resourceIdentityAgencyV3Import(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*cfg.Config)
+	client, err := agencyClient(d, config)
+	if err != nil {
+		return nil, fmt.Errorf("error creating client: %s", err)
+	}
+	a, err := agency.Get(client, d.Id()).Extract()
+	if err != nil {
+		return nil, fmt.Errorf("error reading agency %s: %s", d.Id(), err)
+	}
+	if err := readIdentityAgencyV3ProjectRoles(d, client, a.DomainID, true); err != nil {
+		return nil, err
+	}
+	if err := readIdentityAgencyV3DomainRoles(d, client, a.DomainID, true); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceIdentityAgencyV3Update(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*cfg.Config)
 	client, err := agencyClient(d, config)
@@ -446,11 +536,14 @@ func resourceIdentityAgencyV3Update(d *schema.ResourceData, meta interface{}) er
 
 	aID := d.Id()
 
-	if d.HasChange("delegated_domain_name") || d.HasChange("description") {
-		updateOpts := agency.UpdateOpts{
-			DelegatedDomain: d.Get("delegated_domain_name").(string),
+	if d.HasChanges("delegated_domain_name", "delegated_service_name", "description", "duration") {
+		updateOpts := AgencyUpdateOpts{
+			DelegatedDomain: agencyTrustTarget(d),
 			Description:     d.Get("description").(string),
 		}
+		if d.HasChange("duration") {
+			updateOpts.Duration = d.Get("duration").(string)
+		}
 		log.Printf("[DEBUG] Updating Identity-Agency %s with options: %#v", aID, updateOpts)
 		timeout := d.Timeout(schema.TimeoutUpdate)
 		err = resource.Retry(timeout, func() *resource.RetryError {
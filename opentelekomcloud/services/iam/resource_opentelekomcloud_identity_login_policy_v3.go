@@ -0,0 +1,150 @@
+package iam
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceIdentityLoginPolicyV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIdentityLoginPolicyV3Update,
+		Read:   resourceIdentityLoginPolicyV3Read,
+		Update: resourceIdentityLoginPolicyV3Update,
+		Delete: resourceIdentityLoginPolicyV3Delete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_validity_period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 240),
+			},
+			"custom_info_for_login": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 64),
+			},
+			"lockout_duration": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      15,
+				ValidateFunc: validation.IntBetween(15, 1440),
+			},
+			"login_failed_times": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validation.IntBetween(3, 10),
+			},
+			"period_with_login_failures": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      15,
+				ValidateFunc: validation.IntBetween(15, 60),
+			},
+			"session_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      60,
+				ValidateFunc: validation.IntBetween(15, 1440),
+			},
+			"show_recent_login_info": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+// defaultLoginPolicy is the policy set on the resource deletion
+var defaultLoginPolicy = LoginPolicy{
+	AccountValidityPeriod:   0,
+	CustomInfoForLogin:      "",
+	LockoutDuration:         15,
+	LoginFailedTimes:        5,
+	PeriodWithLoginFailures: 15,
+	SessionTimeout:          60,
+	ShowRecentLoginInfo:     false,
+}
+
+func resourceIdentityLoginPolicyV3Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.IdentityV30Client()
+	if err != nil {
+		return fmt.Errorf("error creating identity v3.0 client: %s", err)
+	}
+
+	domainID, err := getDomainID(config, client)
+	if err != nil {
+		return fmt.Errorf("error getting domain ID: %s", err)
+	}
+
+	policy := LoginPolicy{
+		AccountValidityPeriod:   d.Get("account_validity_period").(int),
+		CustomInfoForLogin:      d.Get("custom_info_for_login").(string),
+		LockoutDuration:         d.Get("lockout_duration").(int),
+		LoginFailedTimes:        d.Get("login_failed_times").(int),
+		PeriodWithLoginFailures: d.Get("period_with_login_failures").(int),
+		SessionTimeout:          d.Get("session_timeout").(int),
+		ShowRecentLoginInfo:     d.Get("show_recent_login_info").(bool),
+	}
+	log.Printf("[DEBUG] Updating login policy of domain %s: %#v", domainID, policy)
+	if err := updateLoginPolicy(client, domainID, policy); err != nil {
+		return fmt.Errorf("error updating login policy: %s", err)
+	}
+
+	d.SetId(domainID)
+
+	return resourceIdentityLoginPolicyV3Read(d, meta)
+}
+
+func resourceIdentityLoginPolicyV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.IdentityV30Client()
+	if err != nil {
+		return fmt.Errorf("error creating identity v3.0 client: %s", err)
+	}
+
+	policy, err := getLoginPolicy(client, d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading login policy: %s", err)
+	}
+	log.Printf("[DEBUG] Retrieved login policy: %#v", policy)
+
+	mErr := multierror.Append(nil,
+		d.Set("account_validity_period", policy.AccountValidityPeriod),
+		d.Set("custom_info_for_login", policy.CustomInfoForLogin),
+		d.Set("lockout_duration", policy.LockoutDuration),
+		d.Set("login_failed_times", policy.LoginFailedTimes),
+		d.Set("period_with_login_failures", policy.PeriodWithLoginFailures),
+		d.Set("session_timeout", policy.SessionTimeout),
+		d.Set("show_recent_login_info", policy.ShowRecentLoginInfo),
+	)
+	return mErr.ErrorOrNil()
+}
+
+func resourceIdentityLoginPolicyV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.IdentityV30Client()
+	if err != nil {
+		return fmt.Errorf("error creating identity v3.0 client: %s", err)
+	}
+
+	if err := updateLoginPolicy(client, d.Id(), defaultLoginPolicy); err != nil {
+		return fmt.Errorf("error resetting login policy: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,127 @@
+package iam
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceIdentityLoginProtectionV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIdentityLoginProtectionV3Create,
+		Read:   resourceIdentityLoginProtectionV3Read,
+		Update: resourceIdentityLoginProtectionV3Update,
+		Delete: resourceIdentityLoginProtectionV3Delete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"verification_method": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"vmfa", "sms", "email",
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceIdentityLoginProtectionV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.IdentityV30Client()
+	if err != nil {
+		return fmt.Errorf("error creating identity v3.0 client: %s", err)
+	}
+
+	userID := d.Get("user_id").(string)
+	protection := LoginProtection{
+		Enabled:            d.Get("enabled").(bool),
+		VerificationMethod: d.Get("verification_method").(string),
+	}
+	log.Printf("[DEBUG] Setting login protection of user %s: %#v", userID, protection)
+	if err := updateLoginProtection(client, userID, protection); err != nil {
+		return fmt.Errorf("error setting login protection: %s", err)
+	}
+
+	d.SetId(userID)
+
+	return resourceIdentityLoginProtectionV3Read(d, meta)
+}
+
+func resourceIdentityLoginProtectionV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.IdentityV30Client()
+	if err != nil {
+		return fmt.Errorf("error creating identity v3.0 client: %s", err)
+	}
+
+	protection, err := getLoginProtection(client, d.Id())
+	if err != nil {
+		return common.CheckDeleted(d, err, "login protection")
+	}
+	log.Printf("[DEBUG] Retrieved login protection: %#v", protection)
+
+	mErr := multierror.Append(nil,
+		d.Set("user_id", d.Id()),
+		d.Set("enabled", protection.Enabled),
+		d.Set("verification_method", protection.VerificationMethod),
+	)
+	return mErr.ErrorOrNil()
+}
+
+func resourceIdentityLoginProtectionV3Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.IdentityV30Client()
+	if err != nil {
+		return fmt.Errorf("error creating identity v3.0 client: %s", err)
+	}
+
+	protection := LoginProtection{
+		Enabled:            d.Get("enabled").(bool),
+		VerificationMethod: d.Get("verification_method").(string),
+	}
+	log.Printf("[DEBUG] Updating login protection of user %s: %#v", d.Id(), protection)
+	if err := updateLoginProtection(client, d.Id(), protection); err != nil {
+		return fmt.Errorf("error updating login protection: %s", err)
+	}
+
+	return resourceIdentityLoginProtectionV3Read(d, meta)
+}
+
+func resourceIdentityLoginProtectionV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.IdentityV30Client()
+	if err != nil {
+		return fmt.Errorf("error creating identity v3.0 client: %s", err)
+	}
+
+	protection := LoginProtection{
+		Enabled:            false,
+		VerificationMethod: "none",
+	}
+	if err := updateLoginProtection(client, d.Id(), protection); err != nil {
+		return common.CheckDeleted(d, err, "login protection")
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,159 @@
+package iam
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceIdentityMappingV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIdentityMappingV3Create,
+		Read:   resourceIdentityMappingV3Read,
+		Update: resourceIdentityMappingV3Update,
+		Delete: resourceIdentityMappingV3Delete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"mapping_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(identityProviderNameRegexp, "only letters, digits, `-` and `_` are allowed"),
+				),
+			},
+			"rules": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateIdentityMappingRules,
+				StateFunc: func(v interface{}) string {
+					jsonString, _ := common.NormalizeJsonString(v)
+					return jsonString
+				},
+			},
+		},
+	}
+}
+
+// validateIdentityMappingRules checks that the rules are valid JSON array of rules
+func validateIdentityMappingRules(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := expandIdentityMappingRules(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains invalid mapping rules: %s", k, err))
+	}
+	return
+}
+
+func expandIdentityMappingRules(rules string) ([]interface{}, error) {
+	var res []interface{}
+	if err := json.Unmarshal([]byte(rules), &res); err != nil {
+		return nil, err
+	}
+	for i, rule := range res {
+		ruleMap, ok := rule.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("rule #%d is not an object", i)
+		}
+		if _, ok := ruleMap["local"]; !ok {
+			return nil, fmt.Errorf("rule #%d has no `local` field", i)
+		}
+		if _, ok := ruleMap["remote"]; !ok {
+			return nil, fmt.Errorf("rule #%d has no `remote` field", i)
+		}
+	}
+	return res, nil
+}
+
+func resourceIdentityMappingV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.IdentityV3Client()
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud identity client: %s", err)
+	}
+
+	rules, err := expandIdentityMappingRules(d.Get("rules").(string))
+	if err != nil {
+		return err
+	}
+
+	mappingID := d.Get("mapping_id").(string)
+	log.Printf("[DEBUG] Creating identity mapping %s with rules: %#v", mappingID, rules)
+	if err := createIdentityMapping(client, mappingID, rules); err != nil {
+		return fmt.Errorf("error creating identity mapping: %s", err)
+	}
+
+	d.SetId(mappingID)
+
+	return resourceIdentityMappingV3Read(d, meta)
+}
+
+func resourceIdentityMappingV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.IdentityV3Client()
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud identity client: %s", err)
+	}
+
+	mapping, err := getIdentityMapping(client, d.Id())
+	if err != nil {
+		return common.CheckDeleted(d, err, "identity mapping")
+	}
+	log.Printf("[DEBUG] Retrieved identity mapping: %#v", mapping)
+
+	rules, err := json.Marshal(mapping.Rules)
+	if err != nil {
+		return fmt.Errorf("error marshalling mapping rules: %s", err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("mapping_id", mapping.ID),
+		d.Set("rules", string(rules)),
+	)
+	return mErr.ErrorOrNil()
+}
+
+func resourceIdentityMappingV3Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.IdentityV3Client()
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud identity client: %s", err)
+	}
+
+	if d.HasChange("rules") {
+		rules, err := expandIdentityMappingRules(d.Get("rules").(string))
+		if err != nil {
+			return err
+		}
+		if err := updateIdentityMapping(client, d.Id(), rules); err != nil {
+			return fmt.Errorf("error updating identity mapping: %s", err)
+		}
+	}
+
+	return resourceIdentityMappingV3Read(d, meta)
+}
+
+func resourceIdentityMappingV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.IdentityV3Client()
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud identity client: %s", err)
+	}
+
+	if err := deleteIdentityMapping(client, d.Id()); err != nil {
+		return common.CheckDeleted(d, err, "identity mapping")
+	}
+
+	d.SetId("")
+	return nil
+}
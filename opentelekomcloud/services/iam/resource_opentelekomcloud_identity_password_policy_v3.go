@@ -0,0 +1,155 @@
+package iam
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceIdentityPasswordPolicyV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIdentityPasswordPolicyV3Update,
+		Read:   resourceIdentityPasswordPolicyV3Read,
+		Update: resourceIdentityPasswordPolicyV3Update,
+		Delete: resourceIdentityPasswordPolicyV3Delete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"maximum_consecutive_identical_chars": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 32),
+			},
+			"minimum_password_age": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 1440),
+			},
+			"minimum_password_length": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      8,
+				ValidateFunc: validation.IntBetween(6, 32),
+			},
+			"number_of_recent_passwords_disallowed": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(0, 10),
+			},
+			"password_not_username_or_invert": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"password_validity_period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 180),
+			},
+			"password_char_combination": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      2,
+				ValidateFunc: validation.IntBetween(2, 4),
+			},
+		},
+	}
+}
+
+// defaultPasswordPolicy is the policy set on the resource deletion
+var defaultPasswordPolicy = PasswordPolicy{
+	MaximumConsecutiveIdenticalChars:  0,
+	MinimumPasswordAge:                0,
+	MinimumPasswordLength:             8,
+	NumberOfRecentPasswordsDisallowed: 1,
+	PasswordNotUsernameOrInvert:       true,
+	PasswordValidityPeriod:            0,
+	PasswordCharCombination:           2,
+}
+
+func resourceIdentityPasswordPolicyV3Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.IdentityV30Client()
+	if err != nil {
+		return fmt.Errorf("error creating identity v3.0 client: %s", err)
+	}
+
+	domainID, err := getDomainID(config, client)
+	if err != nil {
+		return fmt.Errorf("error getting domain ID: %s", err)
+	}
+
+	policy := PasswordPolicy{
+		MaximumConsecutiveIdenticalChars:  d.Get("maximum_consecutive_identical_chars").(int),
+		MinimumPasswordAge:                d.Get("minimum_password_age").(int),
+		MinimumPasswordLength:             d.Get("minimum_password_length").(int),
+		NumberOfRecentPasswordsDisallowed: d.Get("number_of_recent_passwords_disallowed").(int),
+		PasswordNotUsernameOrInvert:       d.Get("password_not_username_or_invert").(bool),
+		PasswordValidityPeriod:            d.Get("password_validity_period").(int),
+		PasswordCharCombination:           d.Get("password_char_combination").(int),
+	}
+	log.Printf("[DEBUG] Updating password policy of domain %s: %#v", domainID, policy)
+	if err := updatePasswordPolicy(client, domainID, policy); err != nil {
+		return fmt.Errorf("error updating password policy: %s", err)
+	}
+
+	d.SetId(domainID)
+
+	return resourceIdentityPasswordPolicyV3Read(d, meta)
+}
+
+func resourceIdentityPasswordPolicyV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.IdentityV30Client()
+	if err != nil {
+		return fmt.Errorf("error creating identity v3.0 client: %s", err)
+	}
+
+	policy, err := getPasswordPolicy(client, d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading password policy: %s", err)
+	}
+	log.Printf("[DEBUG] Retrieved password policy: %#v", policy)
+
+	mErr := multierror.Append(nil,
+		d.Set("maximum_consecutive_identical_chars", policy.MaximumConsecutiveIdenticalChars),
+		d.Set("minimum_password_age", policy.MinimumPasswordAge),
+		d.Set("minimum_password_length", policy.MinimumPasswordLength),
+		d.Set("number_of_recent_passwords_disallowed", policy.NumberOfRecentPasswordsDisallowed),
+		d.Set("password_not_username_or_invert", policy.PasswordNotUsernameOrInvert),
+		d.Set("password_validity_period", policy.PasswordValidityPeriod),
+	)
+	if policy.PasswordCharCombination != 0 {
+		mErr = multierror.Append(mErr,
+			d.Set("password_char_combination", policy.PasswordCharCombination),
+		)
+	}
+	return mErr.ErrorOrNil()
+}
+
+func resourceIdentityPasswordPolicyV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.IdentityV30Client()
+	if err != nil {
+		return fmt.Errorf("error creating identity v3.0 client: %s", err)
+	}
+
+	if err := updatePasswordPolicy(client, d.Id(), defaultPasswordPolicy); err != nil {
+		return fmt.Errorf("error resetting password policy: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
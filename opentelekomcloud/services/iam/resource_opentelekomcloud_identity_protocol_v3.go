@@ -0,0 +1,154 @@
+package iam
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceIdentityProtocolV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIdentityProtocolV3Create,
+		Read:   resourceIdentityProtocolV3Read,
+		Update: resourceIdentityProtocolV3Update,
+		Delete: resourceIdentityProtocolV3Delete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceIdentityProtocolV3Import,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"provider_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"saml", "oidc",
+				}, false),
+			},
+			"mapping_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceIdentityProtocolV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.IdentityV3Client()
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud identity client: %s", err)
+	}
+
+	providerID := d.Get("provider_id").(string)
+	protocol := d.Get("protocol").(string)
+	mappingID := d.Get("mapping_id").(string)
+	log.Printf("[DEBUG] Creating identity protocol %s of provider %s with mapping %s", protocol, providerID, mappingID)
+	if err := createIdentityProtocol(client, providerID, protocol, mappingID); err != nil {
+		return fmt.Errorf("error creating identity protocol: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", providerID, protocol))
+
+	return resourceIdentityProtocolV3Read(d, meta)
+}
+
+func resourceIdentityProtocolV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.IdentityV3Client()
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud identity client: %s", err)
+	}
+
+	providerID, protocolID, err := parseIdentityProtocolID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	protocol, err := getIdentityProtocol(client, providerID, protocolID)
+	if err != nil {
+		return common.CheckDeleted(d, err, "identity protocol")
+	}
+	log.Printf("[DEBUG] Retrieved identity protocol: %#v", protocol)
+
+	mErr := multierror.Append(nil,
+		d.Set("provider_id", providerID),
+		d.Set("protocol", protocol.ID),
+		d.Set("mapping_id", protocol.MappingID),
+	)
+	return mErr.ErrorOrNil()
+}
+
+func resourceIdentityProtocolV3Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.IdentityV3Client()
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud identity client: %s", err)
+	}
+
+	if d.HasChange("mapping_id") {
+		providerID := d.Get("provider_id").(string)
+		protocol := d.Get("protocol").(string)
+		if err := updateIdentityProtocol(client, providerID, protocol, d.Get("mapping_id").(string)); err != nil {
+			return fmt.Errorf("error updating identity protocol: %s", err)
+		}
+	}
+
+	return resourceIdentityProtocolV3Read(d, meta)
+}
+
+func resourceIdentityProtocolV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.IdentityV3Client()
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud identity client: %s", err)
+	}
+
+	providerID, protocol, err := parseIdentityProtocolID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := deleteIdentityProtocol(client, providerID, protocol); err != nil {
+		return common.CheckDeleted(d, err, "identity protocol")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func parseIdentityProtocolID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid ID format, must be <provider_id>/<protocol>")
+	}
+	return parts[0], parts[1], nil
+}
+
+func resourceIdentityProtocolV3Import(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	providerID, protocol, err := parseIdentityProtocolID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	mErr := multierror.Append(nil,
+		d.Set("provider_id", providerID),
+		d.Set("protocol", protocol),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
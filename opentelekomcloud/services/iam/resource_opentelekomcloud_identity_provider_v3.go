@@ -0,0 +1,327 @@
+package iam
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceIdentityProviderV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIdentityProviderV3Create,
+		Read:   resourceIdentityProviderV3Read,
+		Update: resourceIdentityProviderV3Update,
+		Delete: resourceIdentityProviderV3Delete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(identityProviderNameRegexp, "only letters, digits, `-` and `_` are allowed"),
+				),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"saml_metadata": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ConflictsWith:    []string{"openid_connect_config"},
+				DiffSuppressFunc: suppressSurroundingSpacesDiffs,
+			},
+			"openid_connect_config": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"saml_metadata"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"access_mode": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"program", "program_console",
+							}, false),
+						},
+						"idp_url": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"client_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"signing_key": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     common.ValidateJsonString,
+							DiffSuppressFunc: common.SuppressEquivalentJsonDiffs,
+						},
+						"authorization_endpoint": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"scope": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "openid",
+						},
+						"response_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "id_token",
+						},
+						"response_mode": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "form_post",
+							ValidateFunc: validation.StringInSlice([]string{
+								"fragment", "form_post",
+							}, false),
+						},
+					},
+				},
+			},
+			"remote_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func expandOIDCConfig(d *schema.ResourceData) *OIDCConfig {
+	configs := d.Get("openid_connect_config").([]interface{})
+	if len(configs) == 0 {
+		return nil
+	}
+	config := configs[0].(map[string]interface{})
+	return &OIDCConfig{
+		AccessMode:            config["access_mode"].(string),
+		IdpURL:                config["idp_url"].(string),
+		ClientID:              config["client_id"].(string),
+		SigningKey:            config["signing_key"].(string),
+		AuthorizationEndpoint: config["authorization_endpoint"].(string),
+		Scope:                 config["scope"].(string),
+		ResponseType:          config["response_type"].(string),
+		ResponseMode:          config["response_mode"].(string),
+	}
+}
+
+func uploadIdentityProviderSAMLMetadata(d *schema.ResourceData, config *cfg.Config, client *golangsdk.ServiceClient) error {
+	domainID, err := getDomainID(config, client)
+	if err != nil {
+		return fmt.Errorf("error getting domain ID: %s", err)
+	}
+	opts := SAMLMetadataOpts{
+		DomainID: domainID,
+		Metadata: d.Get("saml_metadata").(string),
+	}
+	if err := uploadSAMLMetadata(client, d.Id(), opts); err != nil {
+		return fmt.Errorf("error uploading SAML metadata: %s", err)
+	}
+	return nil
+}
+
+func suppressSurroundingSpacesDiffs(_, old, new string, _ *schema.ResourceData) bool {
+	return strings.TrimSpace(old) == strings.TrimSpace(new)
+}
+
+func resourceIdentityProviderV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.IdentityV3Client()
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud identity client: %s", err)
+	}
+
+	name := d.Get("name").(string)
+	opts := IdentityProviderOpts{
+		Description: d.Get("description").(string),
+		Enabled:     d.Get("enabled").(bool),
+	}
+	log.Printf("[DEBUG] Create Options: %#v", opts)
+	if err := createIdentityProvider(client, name, opts); err != nil {
+		return fmt.Errorf("error creating identity provider: %s", err)
+	}
+
+	d.SetId(name)
+
+	if _, ok := d.GetOk("saml_metadata"); ok {
+		if err := uploadIdentityProviderSAMLMetadata(d, config, client); err != nil {
+			return err
+		}
+	}
+
+	if oidcConfig := expandOIDCConfig(d); oidcConfig != nil {
+		client30, err := config.IdentityV30Client()
+		if err != nil {
+			return fmt.Errorf("error creating identity v3.0 client: %s", err)
+		}
+		if err := createOIDCConfig(client30, d.Id(), *oidcConfig); err != nil {
+			return fmt.Errorf("error creating OpenID Connect configuration: %s", err)
+		}
+	}
+
+	return resourceIdentityProviderV3Read(d, meta)
+}
+
+func resourceIdentityProviderV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.IdentityV3Client()
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud identity client: %s", err)
+	}
+
+	provider, err := getIdentityProvider(client, d.Id())
+	if err != nil {
+		return common.CheckDeleted(d, err, "identity provider")
+	}
+	log.Printf("[DEBUG] Retrieved identity provider: %#v", provider)
+
+	mErr := multierror.Append(nil,
+		d.Set("name", provider.ID),
+		d.Set("description", provider.Description),
+		d.Set("enabled", provider.Enabled),
+		d.Set("remote_ids", provider.RemoteIDs),
+	)
+
+	protocols, err := listIdentityProtocols(client, d.Id())
+	if err != nil {
+		return fmt.Errorf("error listing identity provider protocols: %s", err)
+	}
+	hasProtocol := func(protocol string) bool {
+		for _, p := range protocols {
+			if p.ID == protocol {
+				return true
+			}
+		}
+		return false
+	}
+
+	// SAML metadata and OpenID Connect configuration are read only when they are managed or
+	// the protocol is configured, to not depend on the availability of the extension APIs
+	if _, ok := d.GetOk("saml_metadata"); ok || hasProtocol("saml") {
+		metadata, err := getSAMLMetadata(client, d.Id())
+		switch err.(type) {
+		case nil:
+			mErr = multierror.Append(mErr, d.Set("saml_metadata", metadata.Data))
+		case golangsdk.ErrDefault404:
+			mErr = multierror.Append(mErr, d.Set("saml_metadata", ""))
+		default:
+			return fmt.Errorf("error reading SAML metadata: %s", err)
+		}
+	}
+
+	if _, ok := d.GetOk("openid_connect_config"); ok || hasProtocol("oidc") {
+		client30, err := config.IdentityV30Client()
+		if err != nil {
+			return fmt.Errorf("error creating identity v3.0 client: %s", err)
+		}
+		oidcConfig, err := getOIDCConfig(client30, d.Id())
+		switch err.(type) {
+		case nil:
+			mErr = multierror.Append(mErr, d.Set("openid_connect_config", []interface{}{
+				map[string]interface{}{
+					"access_mode":            oidcConfig.AccessMode,
+					"idp_url":                oidcConfig.IdpURL,
+					"client_id":              oidcConfig.ClientID,
+					"signing_key":            oidcConfig.SigningKey,
+					"authorization_endpoint": oidcConfig.AuthorizationEndpoint,
+					"scope":                  oidcConfig.Scope,
+					"response_type":          oidcConfig.ResponseType,
+					"response_mode":          oidcConfig.ResponseMode,
+				},
+			}))
+		case golangsdk.ErrDefault404:
+			mErr = multierror.Append(mErr, d.Set("openid_connect_config", nil))
+		default:
+			return fmt.Errorf("error reading OpenID Connect configuration: %s", err)
+		}
+	}
+
+	return mErr.ErrorOrNil()
+}
+
+func resourceIdentityProviderV3Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.IdentityV3Client()
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud identity client: %s", err)
+	}
+
+	if d.HasChanges("description", "enabled") {
+		opts := IdentityProviderOpts{
+			Description: d.Get("description").(string),
+			Enabled:     d.Get("enabled").(bool),
+		}
+		log.Printf("[DEBUG] Update Options: %#v", opts)
+		if err := updateIdentityProvider(client, d.Id(), opts); err != nil {
+			return fmt.Errorf("error updating identity provider: %s", err)
+		}
+	}
+
+	if d.HasChange("saml_metadata") && d.Get("saml_metadata").(string) != "" {
+		if err := uploadIdentityProviderSAMLMetadata(d, config, client); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("openid_connect_config") {
+		client30, err := config.IdentityV30Client()
+		if err != nil {
+			return fmt.Errorf("error creating identity v3.0 client: %s", err)
+		}
+		oidcConfig := expandOIDCConfig(d)
+		old, _ := d.GetChange("openid_connect_config")
+		switch {
+		case oidcConfig == nil:
+			log.Printf("[WARN] OpenID Connect configuration can't be removed, only protocol and mapping can")
+		case len(old.([]interface{})) == 0:
+			err = createOIDCConfig(client30, d.Id(), *oidcConfig)
+		default:
+			err = updateOIDCConfig(client30, d.Id(), *oidcConfig)
+		}
+		if err != nil {
+			return fmt.Errorf("error updating OpenID Connect configuration: %s", err)
+		}
+	}
+
+	return resourceIdentityProviderV3Read(d, meta)
+}
+
+func resourceIdentityProviderV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.IdentityV3Client()
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud identity client: %s", err)
+	}
+
+	if err := deleteIdentityProvider(client, d.Id()); err != nil {
+		return common.CheckDeleted(d, err, "identity provider")
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -1,6 +1,7 @@
 package iam
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"reflect"
@@ -40,8 +41,10 @@ func ResourceIdentityRoleV3() *schema.Resource {
 			},
 
 			"statement": {
-				Type:     schema.TypeList,
-				Required: true,
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"statement", "policy"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"action": {
@@ -59,6 +62,15 @@ func ResourceIdentityRoleV3() *schema.Resource {
 				},
 			},
 
+			"policy": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ExactlyOneOf:     []string{"statement", "policy"},
+				ValidateFunc:     common.ValidateJsonString,
+				DiffSuppressFunc: common.SuppressEquivalentIdentityPolicyDiffs,
+			},
+
 			"catalog": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -83,9 +95,23 @@ func resourceIdentityRoleV3UserInputParams(d *schema.ResourceData) map[string]in
 		"display_layer": d.Get("display_layer"),
 		"display_name":  d.Get("display_name"),
 		"statement":     d.Get("statement"),
+		"policy":        d.Get("policy"),
 	}
 }
 
+// expandIdentityRoleV3RawPolicy returns policy set as JSON document, if any
+func expandIdentityRoleV3RawPolicy(opts interface{}) (interface{}, error) {
+	policy, _ := opts.(map[string]interface{})["policy"].(string)
+	if policy == "" {
+		return nil, nil
+	}
+	var result interface{}
+	if err := json.Unmarshal([]byte(policy), &result); err != nil {
+		return nil, fmt.Errorf("error parsing policy: %s", err)
+	}
+	return result, nil
+}
+
 func resourceIdentityRoleV3Create(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*cfg.Config)
 	client, err := config.IdentityV30Client()
@@ -134,6 +160,10 @@ func resourceIdentityRoleV3Update(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	opts := resourceIdentityRoleV3UserInputParams(d)
+	// `policy` contains the current document, it's replaced by statements when they're changed
+	if d.HasChange("statement") {
+		delete(opts, "policy")
+	}
 
 	_, err = sendIdentityRoleV3UpdateRequest(d, opts, nil, client)
 	if err != nil {
@@ -247,6 +277,11 @@ func buildIdentityRoleV3CreateParameters(opts map[string]interface{}, arrayIndex
 }
 
 func expandIdentityRoleV3CreatePolicy(d interface{}, arrayIndex map[string]int) (interface{}, error) {
+	rawPolicy, err := expandIdentityRoleV3RawPolicy(d)
+	if err != nil || rawPolicy != nil {
+		return rawPolicy, err
+	}
+
 	req := make(map[string]interface{})
 
 	statementProp, err := expandIdentityRoleV3CreatePolicyStatement(d, arrayIndex)
@@ -408,6 +443,11 @@ func buildIdentityRoleV3UpdateParameters(opts map[string]interface{}, arrayIndex
 }
 
 func expandIdentityRoleV3UpdatePolicy(d interface{}, arrayIndex map[string]int) (interface{}, error) {
+	rawPolicy, err := expandIdentityRoleV3RawPolicy(d)
+	if err != nil || rawPolicy != nil {
+		return rawPolicy, err
+	}
+
 	req := make(map[string]interface{})
 
 	statementProp, err := expandIdentityRoleV3UpdatePolicyStatement(d, arrayIndex)
@@ -516,15 +556,28 @@ func readIdentityRoleV3Read(d *schema.ResourceData, client *golangsdk.ServiceCli
 func setIdentityRoleV3Properties(d *schema.ResourceData, response map[string]interface{}) error {
 	opts := resourceIdentityRoleV3UserInputParams(d)
 
-	statementProp, _ := opts["statement"]
-	statementProp, err := flattenIdentityRoleV3Statement(response, nil, statementProp)
+	// statements are always built from the response, as they can be changed via `policy`
+	statementProp, err := flattenIdentityRoleV3Statement(response, nil, nil)
 	if err != nil {
 		return fmt.Errorf("error reading Role:statement, err: %s", err)
 	}
+	keepIdentityRoleV3ActionsOrder(statementProp.([]interface{}), opts["statement"])
 	if err = d.Set("statement", statementProp); err != nil {
 		return fmt.Errorf("error setting Role:statement, err: %s", err)
 	}
 
+	policyProp, err := common.NavigateValue(response, []string{"read", "policy"}, nil)
+	if err != nil {
+		return fmt.Errorf("error reading Role:policy, err: %s", err)
+	}
+	policyJSON, err := json.Marshal(policyProp)
+	if err != nil {
+		return fmt.Errorf("error converting Role:policy to JSON, err: %s", err)
+	}
+	if err = d.Set("policy", string(policyJSON)); err != nil {
+		return fmt.Errorf("error setting Role:policy, err: %s", err)
+	}
+
 	catalogProp, err := common.NavigateValue(response, []string{"read", "catalog"}, nil)
 	if err != nil {
 		return fmt.Errorf("error reading Role:catalog, err: %s", err)
@@ -618,6 +671,26 @@ func flattenIdentityRoleV3Statement(d interface{}, arrayIndex map[string]int, cu
 	return result, nil
 }
 
+// keepIdentityRoleV3ActionsOrder keeps the configured order of the statement actions
+// if API returns the same actions in different order
+func keepIdentityRoleV3ActionsOrder(statements []interface{}, current interface{}) {
+	currentStatements, _ := current.([]interface{})
+	for i, v := range statements {
+		if i >= len(currentStatements) || currentStatements[i] == nil {
+			break
+		}
+		statement := v.(map[string]interface{})
+		currentActions, _ := currentStatements[i].(map[string]interface{})["action"].([]interface{})
+		actions, _ := statement["action"].([]interface{})
+		if len(actions) != len(currentActions) {
+			continue
+		}
+		if schema.NewSet(schema.HashString, actions).Equal(schema.NewSet(schema.HashString, currentActions)) {
+			statement["action"] = currentActions
+		}
+	}
+}
+
 func flattenIdentityRoleV3DisplayLayer(d interface{}, arrayIndex map[string]int, _ interface{}) (interface{}, error) {
 	v, err := common.NavigateValue(d, []string{"read", "type"}, arrayIndex)
 	if err != nil {
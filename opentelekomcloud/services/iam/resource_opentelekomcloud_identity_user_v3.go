@@ -3,9 +3,12 @@ package iam
 import (
 	"fmt"
 	"log"
+	"regexp"
+	"strings"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/identity/v3/users"
 
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
@@ -71,10 +74,59 @@ func ResourceIdentityUserV3() *schema.Resource {
 				Optional:         true,
 				DiffSuppressFunc: common.SuppressCaseInsensitive,
 			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"phone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringMatch(userPhoneRegexp,
+					"phone must be in `<country code>-<number>` format, e.g. `0049-123456789`"),
+			},
+
+			"access_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "default",
+				ValidateFunc: validation.StringInSlice([]string{
+					"default", "programmatic", "console",
+				}, false),
+			},
+
+			"pwd_reset": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"send_welcome_email": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"last_login_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
 
+var userPhoneRegexp = regexp.MustCompile(`^\d{1,8}-\d{1,32}$`)
+
+// splitUserPhone returns country code and number of the phone in `<country code>-<number>` format
+func splitUserPhone(phone string) (string, string) {
+	parts := strings.SplitN(phone, "-", 2)
+	if len(parts) != 2 {
+		return "", phone
+	}
+	return parts[0], parts[1]
+}
+
 func resourceIdentityUserV3Create(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*cfg.Config)
 	identityClient, err := config.IdentityV3Client(config.GetRegion(d))
@@ -88,6 +140,7 @@ func resourceIdentityUserV3Create(d *schema.ResourceData, meta interface{}) erro
 		DomainID:         d.Get("domain_id").(string),
 		Enabled:          &enabled,
 		Name:             d.Get("name").(string),
+		Description:      d.Get("description").(string),
 	}
 
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
@@ -102,7 +155,28 @@ func resourceIdentityUserV3Create(d *schema.ResourceData, meta interface{}) erro
 
 	d.SetId(user.ID)
 
-	return setExtendedOpts(d, meta)
+	if err := setExtendedOpts(d, meta); err != nil {
+		return err
+	}
+
+	if d.Get("send_welcome_email").(bool) {
+		if err := sendIdentityUserWelcomeEmail(d, config); err != nil {
+			return err
+		}
+	}
+
+	return resourceIdentityUserV3Read(d, meta)
+}
+
+func sendIdentityUserWelcomeEmail(d *schema.ResourceData, config *cfg.Config) error {
+	client30, err := config.IdentityV30Client()
+	if err != nil {
+		return fmt.Errorf("error creating identity v3.0 client: %s", err)
+	}
+	if err := sendWelcomeEmail(client30, d.Id()); err != nil {
+		return fmt.Errorf("error sending welcome email to the user: %s", err)
+	}
+	return nil
 }
 
 func resourceIdentityUserV3Read(d *schema.ResourceData, meta interface{}) error {
@@ -124,16 +198,29 @@ func resourceIdentityUserV3Read(d *schema.ResourceData, meta interface{}) error
 		d.Set("domain_id", user.DomainID),
 		d.Set("enabled", user.Enabled),
 		d.Set("name", user.Name),
+		d.Set("description", user.Description),
 		d.Set("region", config.GetRegion(d)),
 	)
 
-	// Read extended options
-	user, err = users.ExtendedUpdate(identityClient, d.Id(), users.ExtendedUpdateOpts{}).Extract()
+	// Read extended options, `password` and `pwd_reset` are write-only
+	// as they're changed by the user on login
+	client30, err := config.IdentityV30Client()
 	if err != nil {
-		return err
+		return fmt.Errorf("error creating identity v3.0 client: %s", err)
+	}
+	userV30, err := getUserV30(client30, d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading extended user attributes: %s", err)
+	}
+	phone := ""
+	if userV30.Phone != "" {
+		phone = fmt.Sprintf("%s-%s", userV30.AreaCode, userV30.Phone)
 	}
 	mErr = multierror.Append(mErr,
-		d.Set("email", user.Email),
+		d.Set("email", userV30.Email),
+		d.Set("phone", phone),
+		d.Set("access_mode", userV30.AccessMode),
+		d.Set("last_login_time", userV30.LastLoginTime),
 	)
 
 	return mErr.ErrorOrNil()
@@ -141,27 +228,52 @@ func resourceIdentityUserV3Read(d *schema.ResourceData, meta interface{}) error
 
 func setExtendedOpts(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*cfg.Config)
-	identityClient, err := config.IdentityV3Client(config.GetRegion(d))
+	client30, err := config.IdentityV30Client()
 	if err != nil {
-		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+		return fmt.Errorf("error creating identity v3.0 client: %s", err)
 	}
 
 	var hasChange bool
-	var updateOpts users.ExtendedUpdateOpts
+	var updateOpts UserV30UpdateOpts
+
+	if d.HasChange("description") {
+		hasChange = true
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
 
 	if d.HasChange("email") {
 		hasChange = true
-		updateOpts.Email = d.Get("email").(string)
+		email := d.Get("email").(string)
+		updateOpts.Email = &email
+	}
+
+	if d.HasChange("phone") {
+		hasChange = true
+		areaCode, phone := splitUserPhone(d.Get("phone").(string))
+		updateOpts.AreaCode = &areaCode
+		updateOpts.Phone = &phone
+	}
+
+	if d.HasChange("access_mode") {
+		hasChange = true
+		updateOpts.AccessMode = d.Get("access_mode").(string)
+	}
+
+	if d.HasChange("pwd_reset") {
+		hasChange = true
+		pwdReset := d.Get("pwd_reset").(bool)
+		updateOpts.PwdStatus = &pwdReset
 	}
 
 	if hasChange {
-		_, err := users.ExtendedUpdate(identityClient, d.Id(), updateOpts).Extract()
-		if err != nil {
-			return fmt.Errorf("Error updating OpenStack user: %s", err)
+		log.Printf("[DEBUG] Extended Update Options: %#v", updateOpts)
+		if err := updateUserV30(client30, d.Id(), updateOpts); err != nil {
+			return fmt.Errorf("error updating extended user attributes: %s", err)
 		}
 	}
 
-	return resourceIdentityUserV3Read(d, meta)
+	return nil
 }
 
 func resourceIdentityUserV3Update(d *schema.ResourceData, meta interface{}) error {
@@ -211,7 +323,18 @@ func resourceIdentityUserV3Update(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
-	return setExtendedOpts(d, meta)
+	if err := setExtendedOpts(d, meta); err != nil {
+		return err
+	}
+
+	// email is sent again only when the value is switched on
+	if d.HasChange("send_welcome_email") && d.Get("send_welcome_email").(bool) {
+		if err := sendIdentityUserWelcomeEmail(d, config); err != nil {
+			return err
+		}
+	}
+
+	return resourceIdentityUserV3Read(d, meta)
 }
 
 func resourceIdentityUserV3Delete(d *schema.ResourceData, meta interface{}) error {
@@ -0,0 +1,79 @@
+package kms
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func DataSourceKmsSecretsV1() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKmsSecretsV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"secret": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"payload": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"encryption_context": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"plaintext": {
+				Type:      schema.TypeMap,
+				Computed:  true,
+				Sensitive: true,
+				Elem:      &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceKmsSecretsV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.KmsKeyV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud KMSv1 client: %s", err)
+	}
+
+	plaintext := make(map[string]string)
+	for _, v := range d.Get("secret").(*schema.Set).List() {
+		secret := v.(map[string]interface{})
+		name := secret["name"].(string)
+
+		opts := DecryptDataV1Opts{
+			CipherText:        secret["payload"].(string),
+			EncryptionContext: make(map[string]string),
+		}
+		for k, v := range secret["encryption_context"].(map[string]interface{}) {
+			opts.EncryptionContext[k] = v.(string)
+		}
+
+		log.Printf("[DEBUG] Decrypting KMS secret: %s", name)
+		value, err := decryptDataV1(client, opts)
+		if err != nil {
+			return fmt.Errorf("error decrypting KMS secret %s: %s", name, err)
+		}
+		plaintext[name] = value
+	}
+
+	d.SetId(config.GetRegion(d))
+
+	return d.Set("plaintext", plaintext)
+}
@@ -0,0 +1,104 @@
+package kms
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"hash"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+const (
+	WrappingAlgorithmOaepSha256 = "RSAES_OAEP_SHA_256"
+	WrappingAlgorithmOaepSha1   = "RSAES_OAEP_SHA_1"
+)
+
+// ImportParametersV1 contains the values required to import the key material.
+type ImportParametersV1 struct {
+	KeyID          string `json:"key_id"`
+	ImportToken    string `json:"import_token"`
+	ExpirationTime int64  `json:"expiration_time"`
+	// PublicKey is base64 encoded DER of the wrapping public key
+	PublicKey string `json:"public_key"`
+}
+
+// ImportKeyMaterialV1Opts contains the values used for the key material import.
+type ImportKeyMaterialV1Opts struct {
+	KeyID                string `json:"key_id" required:"true"`
+	ImportToken          string `json:"import_token" required:"true"`
+	EncryptedKeyMaterial string `json:"encrypted_key_material" required:"true"`
+	ExpirationTime       int64  `json:"expiration_time,omitempty"`
+}
+
+func getImportParametersV1(client *golangsdk.ServiceClient, keyID, wrappingAlgorithm string) (*ImportParametersV1, error) {
+	b := map[string]string{
+		"key_id":             keyID,
+		"wrapping_algorithm": wrappingAlgorithm,
+	}
+	var res ImportParametersV1
+	if _, err := client.Post(kmsURL(client, "get-parameters-for-import"), b, &res, kmsRequestOpts()); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func importKeyMaterialV1(client *golangsdk.ServiceClient, opts ImportKeyMaterialV1Opts) error {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return err
+	}
+	_, err = client.Post(kmsURL(client, "import-key-material"), b, nil, kmsRequestOpts())
+	return err
+}
+
+func deleteKeyMaterialV1(client *golangsdk.ServiceClient, keyID string) error {
+	b := map[string]string{
+		"key_id": keyID,
+	}
+	_, err := client.Post(kmsURL(client, "delete-imported-key-material"), b, nil, kmsRequestOpts())
+	return err
+}
+
+func wrappingHash(algorithm string) (hash.Hash, error) {
+	switch algorithm {
+	case WrappingAlgorithmOaepSha256:
+		return sha256.New(), nil
+	case WrappingAlgorithmOaepSha1:
+		return sha1.New(), nil
+	default:
+		return nil, fmt.Errorf("unsupported wrapping algorithm: %s", algorithm)
+	}
+}
+
+// wrapKeyMaterial encrypts the key material with RSAES-OAEP using the wrapping public key
+// returned by KMS as base64 encoded DER. The result is base64 encoded.
+func wrapKeyMaterial(wrappingKey string, material []byte, algorithm string) (string, error) {
+	h, err := wrappingHash(algorithm)
+	if err != nil {
+		return "", err
+	}
+
+	der, err := base64.StdEncoding.DecodeString(wrappingKey)
+	if err != nil {
+		return "", fmt.Errorf("error decoding wrapping key: %s", err)
+	}
+	parsed, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return "", fmt.Errorf("error parsing wrapping key: %s", err)
+	}
+	publicKey, ok := parsed.(*rsa.PublicKey)
+	if !ok {
+		return "", fmt.Errorf("wrapping key is not an RSA public key: %T", parsed)
+	}
+
+	encrypted, err := rsa.EncryptOAEP(h, rand.Reader, publicKey, material, nil)
+	if err != nil {
+		return "", fmt.Errorf("error wrapping key material: %s", err)
+	}
+	return base64.StdEncoding.EncodeToString(encrypted), nil
+}
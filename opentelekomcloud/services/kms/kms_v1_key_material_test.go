@@ -0,0 +1,82 @@
+package kms
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"hash"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func encodePublicKey(t *testing.T, key interface{}) string {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	th.AssertNoErr(t, err)
+	return base64.StdEncoding.EncodeToString(der)
+}
+
+func TestWrapKeyMaterial(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	th.AssertNoErr(t, err)
+	wrappingKey := encodePublicKey(t, &privateKey.PublicKey)
+
+	material := make([]byte, 32)
+	_, err = rand.Read(material)
+	th.AssertNoErr(t, err)
+
+	cases := map[string]hash.Hash{
+		WrappingAlgorithmOaepSha256: sha256.New(),
+		WrappingAlgorithmOaepSha1:   sha1.New(),
+	}
+	for algorithm, h := range cases {
+		t.Run(algorithm, func(t *testing.T) {
+			wrapped, err := wrapKeyMaterial(wrappingKey, material, algorithm)
+			th.AssertNoErr(t, err)
+
+			encrypted, err := base64.StdEncoding.DecodeString(wrapped)
+			th.AssertNoErr(t, err)
+			th.AssertEquals(t, privateKey.Size(), len(encrypted))
+
+			decrypted, err := rsa.DecryptOAEP(h, rand.Reader, privateKey, encrypted, nil)
+			th.AssertNoErr(t, err)
+			th.AssertByteArrayEquals(t, material, decrypted)
+		})
+	}
+}
+
+func TestWrapKeyMaterialErrors(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	th.AssertNoErr(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	th.AssertNoErr(t, err)
+
+	material := make([]byte, 32)
+	cases := map[string]struct {
+		wrappingKey string
+		algorithm   string
+	}{
+		"unknown algorithm": {encodePublicKey(t, &rsaKey.PublicKey), "RSAES_PKCS1_V1_5"},
+		"invalid base64":    {"not base64!", WrappingAlgorithmOaepSha256},
+		"invalid DER":       {base64.StdEncoding.EncodeToString([]byte("key")), WrappingAlgorithmOaepSha256},
+		"non-RSA key":       {encodePublicKey(t, &ecKey.PublicKey), WrappingAlgorithmOaepSha256},
+		"too long material": {encodePublicKey(t, &rsaKey.PublicKey), WrappingAlgorithmOaepSha256},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			data := material
+			if name == "too long material" {
+				data = make([]byte, rsaKey.Size())
+			}
+			_, err := wrapKeyMaterial(c.wrappingKey, data, c.algorithm)
+			if err == nil {
+				t.Fatal("error expected")
+			}
+		})
+	}
+}
@@ -0,0 +1,234 @@
+package kms
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/kms/v1/keys"
+)
+
+// KeyV1 extends the SDK key with the fields of the asymmetric keys.
+type KeyV1 struct {
+	keys.Key
+	KeySpec  string `json:"key_spec"`
+	KeyUsage string `json:"key_usage"`
+}
+
+// KeyV1CreateOpts contains the values used for the key creation.
+type KeyV1CreateOpts struct {
+	KeyAlias       string `json:"key_alias" required:"true"`
+	KeyDescription string `json:"key_description,omitempty"`
+	Realm          string `json:"realm,omitempty"`
+	KeySpec        string `json:"key_spec,omitempty"`
+	KeyUsage       string `json:"key_usage,omitempty"`
+	Origin         string `json:"origin,omitempty"`
+}
+
+// KeyV1RotationStatus represents the automatic rotation settings of the key.
+type KeyV1RotationStatus struct {
+	Enabled           bool   `json:"key_rotation_enabled"`
+	Interval          int    `json:"rotation_interval"`
+	LastRotationTime  string `json:"last_rotation_time"`
+	NumberOfRotations int    `json:"number_of_rotations"`
+}
+
+// GrantV1CreateOpts contains the values used for the grant creation.
+type GrantV1CreateOpts struct {
+	KeyID                string   `json:"key_id" required:"true"`
+	GranteePrincipal     string   `json:"grantee_principal" required:"true"`
+	GranteePrincipalType string   `json:"grantee_principal_type,omitempty"`
+	Operations           []string `json:"operations" required:"true"`
+	Name                 string   `json:"name,omitempty"`
+	RetiringPrincipal    string   `json:"retiring_principal,omitempty"`
+}
+
+// GrantV1 represents a grant of the key.
+type GrantV1 struct {
+	KeyID                string   `json:"key_id"`
+	GrantID              string   `json:"grant_id"`
+	GranteePrincipal     string   `json:"grantee_principal"`
+	GranteePrincipalType string   `json:"grantee_principal_type"`
+	GrantType            string   `json:"grant_type"`
+	Operations           []string `json:"operations"`
+	IssuingPrincipal     string   `json:"issuing_principal"`
+	CreationDate         string   `json:"creation_date"`
+	Name                 string   `json:"name"`
+	RetiringPrincipal    string   `json:"retiring_principal"`
+}
+
+func kmsURL(client *golangsdk.ServiceClient, action string) string {
+	return client.ServiceURL(client.ProjectID, "kms", action)
+}
+
+func kmsRequestOpts() *golangsdk.RequestOpts {
+	return &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	}
+}
+
+func createKeyV1(client *golangsdk.ServiceClient, opts KeyV1CreateOpts) (*KeyV1, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+	var res struct {
+		KeyInfo KeyV1 `json:"key_info"`
+	}
+	if _, err := client.Post(kmsURL(client, "create-key"), b, &res, kmsRequestOpts()); err != nil {
+		return nil, err
+	}
+	return &res.KeyInfo, nil
+}
+
+func getKeyV1(client *golangsdk.ServiceClient, keyID string) (*KeyV1, error) {
+	b := map[string]string{
+		"key_id": keyID,
+	}
+	var res struct {
+		KeyInfo KeyV1 `json:"key_info"`
+	}
+	if _, err := client.Post(kmsURL(client, "describe-key"), b, &res, kmsRequestOpts()); err != nil {
+		return nil, err
+	}
+	return &res.KeyInfo, nil
+}
+
+// getPublicKeyV1 returns the public key of the asymmetric key in PEM format
+func getPublicKeyV1(client *golangsdk.ServiceClient, keyID string) (string, error) {
+	b := map[string]string{
+		"key_id": keyID,
+	}
+	var res struct {
+		PublicKey string `json:"public_key"`
+	}
+	if _, err := client.Post(kmsURL(client, "get-publickey"), b, &res, kmsRequestOpts()); err != nil {
+		return "", err
+	}
+	return res.PublicKey, nil
+}
+
+func getKeyV1RotationStatus(client *golangsdk.ServiceClient, keyID string) (*KeyV1RotationStatus, error) {
+	b := map[string]string{
+		"key_id": keyID,
+	}
+	var res KeyV1RotationStatus
+	if _, err := client.Post(kmsURL(client, "get-key-rotation-status"), b, &res, kmsRequestOpts()); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func enableKeyV1Rotation(client *golangsdk.ServiceClient, keyID string) error {
+	b := map[string]string{
+		"key_id": keyID,
+	}
+	_, err := client.Post(kmsURL(client, "enable-key-rotation"), b, nil, kmsRequestOpts())
+	return err
+}
+
+func disableKeyV1Rotation(client *golangsdk.ServiceClient, keyID string) error {
+	b := map[string]string{
+		"key_id": keyID,
+	}
+	_, err := client.Post(kmsURL(client, "disable-key-rotation"), b, nil, kmsRequestOpts())
+	return err
+}
+
+func updateKeyV1RotationInterval(client *golangsdk.ServiceClient, keyID string, interval int) error {
+	b := map[string]interface{}{
+		"key_id":            keyID,
+		"rotation_interval": interval,
+	}
+	_, err := client.Post(kmsURL(client, "update-key-rotation-interval"), b, nil, kmsRequestOpts())
+	return err
+}
+
+func createGrantV1(client *golangsdk.ServiceClient, opts GrantV1CreateOpts) (string, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return "", err
+	}
+	var res struct {
+		GrantID string `json:"grant_id"`
+	}
+	if _, err := client.Post(kmsURL(client, "create-grant"), b, &res, kmsRequestOpts()); err != nil {
+		return "", err
+	}
+	return res.GrantID, nil
+}
+
+// listGrantsV1 returns all grants of the key
+func listGrantsV1(client *golangsdk.ServiceClient, keyID string) ([]GrantV1, error) {
+	var grants []GrantV1
+	marker := ""
+	for {
+		b := map[string]string{
+			"key_id": keyID,
+		}
+		if marker != "" {
+			b["marker"] = marker
+		}
+		var res struct {
+			Grants     []GrantV1 `json:"grants"`
+			NextMarker string    `json:"next_marker"`
+			Truncated  string    `json:"truncated"`
+		}
+		if _, err := client.Post(kmsURL(client, "list-grants"), b, &res, kmsRequestOpts()); err != nil {
+			return nil, err
+		}
+		grants = append(grants, res.Grants...)
+		if res.Truncated != "true" || res.NextMarker == "" {
+			return grants, nil
+		}
+		marker = res.NextMarker
+	}
+}
+
+func revokeGrantV1(client *golangsdk.ServiceClient, keyID, grantID string) error {
+	b := map[string]string{
+		"key_id":   keyID,
+		"grant_id": grantID,
+	}
+	_, err := client.Post(kmsURL(client, "revoke-grant"), b, nil, kmsRequestOpts())
+	return err
+}
+
+// EncryptDataV1Opts contains the values used for the data encryption.
+type EncryptDataV1Opts struct {
+	KeyID             string            `json:"key_id" required:"true"`
+	PlainText         string            `json:"plain_text" required:"true"`
+	EncryptionContext map[string]string `json:"encryption_context,omitempty"`
+}
+
+// DecryptDataV1Opts contains the values used for the data decryption.
+type DecryptDataV1Opts struct {
+	CipherText        string            `json:"cipher_text" required:"true"`
+	EncryptionContext map[string]string `json:"encryption_context,omitempty"`
+}
+
+// encryptDataV1 encrypts the plain text with the key returning base64 encoded cipher text
+func encryptDataV1(client *golangsdk.ServiceClient, opts EncryptDataV1Opts) (string, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return "", err
+	}
+	var res struct {
+		CipherText string `json:"cipher_text"`
+	}
+	if _, err := client.Post(kmsURL(client, "encrypt-data"), b, &res, kmsRequestOpts()); err != nil {
+		return "", err
+	}
+	return res.CipherText, nil
+}
+
+func decryptDataV1(client *golangsdk.ServiceClient, opts DecryptDataV1Opts) (string, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return "", err
+	}
+	var res struct {
+		PlainText string `json:"plain_text"`
+	}
+	if _, err := client.Post(kmsURL(client, "decrypt-data"), b, &res, kmsRequestOpts()); err != nil {
+		return "", err
+	}
+	return res.PlainText, nil
+}
@@ -0,0 +1,77 @@
+package kms
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceKmsCiphertextV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKmsCiphertextV1Create,
+		Read:   schema.Noop,
+		Delete: schema.RemoveFromState,
+
+		Schema: map[string]*schema.Schema{
+			"key_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"plaintext": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(1, 4096),
+			},
+			"encryption_context": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"ciphertext_blob": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func expandEncryptionContext(d *schema.ResourceData) map[string]string {
+	context := make(map[string]string)
+	for k, v := range d.Get("encryption_context").(map[string]interface{}) {
+		context[k] = v.(string)
+	}
+	return context
+}
+
+func resourceKmsCiphertextV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.KmsKeyV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud KMSv1 client: %s", err)
+	}
+
+	opts := EncryptDataV1Opts{
+		KeyID:             d.Get("key_id").(string),
+		PlainText:         d.Get("plaintext").(string),
+		EncryptionContext: expandEncryptionContext(d),
+	}
+
+	log.Printf("[DEBUG] Encrypting data with KMS key: %s", opts.KeyID)
+	cipherText, err := encryptDataV1(client, opts)
+	if err != nil {
+		return fmt.Errorf("error encrypting data with KMS key: %s", err)
+	}
+
+	d.SetId(resource.UniqueId())
+
+	return d.Set("ciphertext_blob", cipherText)
+}
@@ -0,0 +1,206 @@
+package kms
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceKmsGrantV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKmsGrantV1Create,
+		Read:   resourceKmsGrantV1Read,
+		Delete: resourceKmsGrantV1Delete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceKmsGrantV1Import,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"key_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"grantee_principal": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"grantee_principal_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "user",
+				ValidateFunc: validation.StringInSlice([]string{
+					"user", "domain",
+				}, false),
+			},
+			"operations": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"create-datakey", "create-datakey-without-plaintext", "encrypt-datakey", "decrypt-datakey",
+						"describe-key", "create-grant", "retire-grant", "encrypt-data", "decrypt-data",
+						"sign", "verify", "get-publickey",
+					}, false),
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 255),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_-]+$`), "only letters, digits, `-` and `_` are allowed"),
+				),
+			},
+			"retiring_principal": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"grant_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"issuing_principal": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceKmsGrantV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.KmsKeyV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud KMSv1 client: %s", err)
+	}
+
+	keyID := d.Get("key_id").(string)
+	createOpts := GrantV1CreateOpts{
+		KeyID:                keyID,
+		GranteePrincipal:     d.Get("grantee_principal").(string),
+		GranteePrincipalType: d.Get("grantee_principal_type").(string),
+		Operations:           common.ExpandToStringSlice(d.Get("operations").(*schema.Set).List()),
+		Name:                 d.Get("name").(string),
+		RetiringPrincipal:    d.Get("retiring_principal").(string),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	grantID, err := createGrantV1(client, createOpts)
+	if err != nil {
+		return fmt.Errorf("error creating KMS grant: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", keyID, grantID))
+
+	return resourceKmsGrantV1Read(d, meta)
+}
+
+func resourceKmsGrantV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.KmsKeyV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud KMSv1 client: %s", err)
+	}
+
+	keyID, grantID, err := parseKmsGrantV1ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	grants, err := listGrantsV1(client, keyID)
+	if err != nil {
+		return common.CheckDeleted(d, err, "KMS grant")
+	}
+
+	var grant *GrantV1
+	for i := range grants {
+		if grants[i].GrantID == grantID {
+			grant = &grants[i]
+			break
+		}
+	}
+	if grant == nil {
+		log.Printf("[WARN] Removing KMS grant %s because it's already gone", d.Id())
+		d.SetId("")
+		return nil
+	}
+	log.Printf("[DEBUG] KMS grant %s: %+v", d.Id(), grant)
+
+	mErr := multierror.Append(nil,
+		d.Set("key_id", grant.KeyID),
+		d.Set("grantee_principal", grant.GranteePrincipal),
+		d.Set("operations", grant.Operations),
+		d.Set("name", grant.Name),
+		d.Set("retiring_principal", grant.RetiringPrincipal),
+		d.Set("grant_type", grant.GrantType),
+		d.Set("issuing_principal", grant.IssuingPrincipal),
+		d.Set("creation_date", grant.CreationDate),
+	)
+	if grant.GranteePrincipalType != "" {
+		mErr = multierror.Append(mErr,
+			d.Set("grantee_principal_type", grant.GranteePrincipalType),
+		)
+	}
+
+	return mErr.ErrorOrNil()
+}
+
+func resourceKmsGrantV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.KmsKeyV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud KMSv1 client: %s", err)
+	}
+
+	keyID, grantID, err := parseKmsGrantV1ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := revokeGrantV1(client, keyID, grantID); err != nil {
+		return common.CheckDeleted(d, err, "KMS grant")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func parseKmsGrantV1ID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid ID format, must be <key_id>/<grant_id>")
+	}
+	return parts[0], parts[1], nil
+}
+
+func resourceKmsGrantV1Import(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	keyID, _, err := parseKmsGrantV1ID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Set("key_id", keyID); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,155 @@
+package kms
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceKmsKeyMaterialV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKmsKeyMaterialV1Create,
+		Read:   resourceKmsKeyMaterialV1Read,
+		Delete: resourceKmsKeyMaterialV1Delete,
+
+		Schema: map[string]*schema.Schema{
+			"key_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"key_material": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringIsBase64,
+			},
+			"wrapping_algorithm": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  WrappingAlgorithmOaepSha256,
+				ValidateFunc: validation.StringInSlice([]string{
+					WrappingAlgorithmOaepSha256, WrappingAlgorithmOaepSha1,
+				}, false),
+			},
+			"expiration_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"key_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceKmsKeyMaterialV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.KmsKeyV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud KMSv1 client: %s", err)
+	}
+
+	keyID := d.Get("key_id").(string)
+	algorithm := d.Get("wrapping_algorithm").(string)
+
+	material, err := base64.StdEncoding.DecodeString(d.Get("key_material").(string))
+	if err != nil {
+		return fmt.Errorf("error decoding key material: %s", err)
+	}
+
+	params, err := getImportParametersV1(client, keyID, algorithm)
+	if err != nil {
+		return fmt.Errorf("error getting import parameters of key %s: %s", keyID, err)
+	}
+
+	wrapped, err := wrapKeyMaterial(params.PublicKey, material, algorithm)
+	if err != nil {
+		return err
+	}
+
+	importOpts := ImportKeyMaterialV1Opts{
+		KeyID:                keyID,
+		ImportToken:          params.ImportToken,
+		EncryptedKeyMaterial: wrapped,
+	}
+	if v, ok := d.GetOk("expiration_time"); ok {
+		expiration, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return err
+		}
+		importOpts.ExpirationTime = expiration.Unix()
+	}
+
+	log.Printf("[DEBUG] Importing key material of key %s, expiration time: %d", keyID, importOpts.ExpirationTime)
+	if err := importKeyMaterialV1(client, importOpts); err != nil {
+		return fmt.Errorf("error importing key material: %s", err)
+	}
+
+	d.SetId(keyID)
+
+	return resourceKmsKeyMaterialV1Read(d, meta)
+}
+
+func resourceKmsKeyMaterialV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.KmsKeyV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud KMSv1 client: %s", err)
+	}
+
+	key, err := getKeyV1(client, d.Id())
+	if err != nil {
+		return common.CheckDeleted(d, err, "KMS key material")
+	}
+
+	log.Printf("[DEBUG] Kms key %s: %+v", d.Id(), key)
+	if key.KeyState == PendingImportState || key.KeyState == PendingDeletionState {
+		log.Printf("[WARN] Removing KMS key material %s because it's already gone", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("key_id", key.KeyID),
+		d.Set("key_state", key.KeyState),
+	)
+	return mErr.ErrorOrNil()
+}
+
+func resourceKmsKeyMaterialV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.KmsKeyV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud KMSv1 client: %s", err)
+	}
+
+	key, err := getKeyV1(client, d.Id())
+	if err != nil {
+		return common.CheckDeleted(d, err, "KMS key material")
+	}
+
+	// material of the key scheduled for deletion can't be deleted
+	if key.KeyState != PendingDeletionState && key.KeyState != PendingImportState {
+		if err := deleteKeyMaterialV1(client, d.Id()); err != nil {
+			return fmt.Errorf("error deleting KMS key material: %s", err)
+		}
+	}
+
+	log.Printf("[DEBUG] KMS key material %s deleted", d.Id())
+	d.SetId("")
+	return nil
+}
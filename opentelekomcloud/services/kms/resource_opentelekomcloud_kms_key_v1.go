@@ -6,8 +6,10 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/opentelekomcloud/gophertelekomcloud"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/kms/v1/keys"
@@ -21,6 +23,10 @@ const (
 	EnabledState          = "2"
 	DisabledState         = "3"
 	PendingDeletionState  = "4"
+	PendingImportState    = "5"
+
+	SymmetricKeySpec = "AES_256"
+	ExternalOrigin   = "external"
 )
 
 func ResourceKmsKeyV1() *schema.Resource {
@@ -34,6 +40,11 @@ func ResourceKmsKeyV1() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: customdiff.All(
+			validateKeyV1Rotation,
+			validateKeyV1ExternalState,
+		),
+
 		Schema: map[string]*schema.Schema{
 			"key_alias": {
 				Type:     schema.TypeString,
@@ -76,13 +87,55 @@ func ResourceKmsKeyV1() *schema.Resource {
 			},
 			"origin": {
 				Type:     schema.TypeString,
+				Optional: true,
 				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"kms", ExternalOrigin,
+				}, false),
 			},
 			"pending_days": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Default:  "7",
 			},
+			"key_spec": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					SymmetricKeySpec, "RSA_2048", "RSA_3072", "RSA_4096", "EC_P256", "EC_P384",
+				}, false),
+			},
+			"key_usage": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"ENCRYPT_DECRYPT", "SIGN_VERIFY",
+				}, false),
+			},
+			"public_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rotation_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"rotation_interval": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(30, 365),
+			},
+			"rotation_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"tags": common.TagsSchema(),
 		},
 	}
@@ -95,25 +148,32 @@ func resourceKmsKeyV1Create(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error creating OpenTelekomCloud KMSv1 client: %s", err)
 	}
 
-	createOpts := &keys.CreateOpts{
+	createOpts := KeyV1CreateOpts{
 		KeyAlias:       d.Get("key_alias").(string),
 		KeyDescription: d.Get("key_description").(string),
 		Realm:          d.Get("realm").(string),
+		KeySpec:        d.Get("key_spec").(string),
+		KeyUsage:       d.Get("key_usage").(string),
+		Origin:         d.Get("origin").(string),
 	}
 
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
-	key, err := keys.Create(client, createOpts).ExtractKeyInfo()
+	key, err := createKeyV1(client, createOpts)
 	if err != nil {
 		return fmt.Errorf("error creating OpenTelekomCloud key: %s", err)
 	}
 	log.Printf("[INFO] Key ID: %s", key.KeyID)
 
-	// Wait for the key to become enabled.
+	// Wait for the key to become enabled, external key waits for the key material instead.
 	log.Printf("[DEBUG] Waiting for key (%s) to become enabled", key.KeyID)
 
+	target := EnabledState
+	if createOpts.Origin == ExternalOrigin {
+		target = PendingImportState
+	}
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{WaitingForEnableState, DisabledState},
-		Target:     []string{EnabledState},
+		Target:     []string{target},
 		Refresh:    keyV1StateRefreshFunc(client, key.KeyID),
 		Timeout:    d.Timeout(schema.TimeoutCreate),
 		Delay:      10 * time.Second,
@@ -125,7 +185,19 @@ func resourceKmsKeyV1Create(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error waiting for key (%s) to become ready: %s", key.KeyID, err)
 	}
 
-	if !d.Get("is_enabled").(bool) {
+	if d.Get("rotation_enabled").(bool) {
+		if err := enableKeyV1Rotation(client, key.KeyID); err != nil {
+			return fmt.Errorf("error enabling key rotation: %s", err)
+		}
+		if v, ok := d.GetOk("rotation_interval"); ok {
+			if err := updateKeyV1RotationInterval(client, key.KeyID, v.(int)); err != nil {
+				return fmt.Errorf("error setting key rotation interval: %s", err)
+			}
+		}
+	}
+
+	// key without key material can't be disabled, it's rejected by CustomizeDiff
+	if !d.Get("is_enabled").(bool) && target == EnabledState {
 		disableKey, err := keys.DisableKey(client, key.KeyID).ExtractKeyInfo()
 		if err != nil {
 			return fmt.Errorf("error disabling key: %s", err)
@@ -158,7 +230,7 @@ func resourceKmsKeyV1Read(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error creating OpenTelekomCloud KMSv1 client: %s", err)
 	}
 
-	key, err := keys.Get(client, d.Id()).ExtractKeyInfo()
+	key, err := getKeyV1(client, d.Id())
 	if err != nil {
 		return err
 	}
@@ -177,16 +249,43 @@ func resourceKmsKeyV1Read(d *schema.ResourceData, meta interface{}) error {
 		d.Set("key_description", key.KeyDescription),
 		d.Set("creation_date", key.CreationDate),
 		d.Set("scheduled_deletion_date", key.ScheduledDeletionDate),
-		d.Set("is_enabled", key.KeyState == EnabledState),
+		// external key becomes enabled as soon as the key material is imported
+		d.Set("is_enabled", key.KeyState == EnabledState || key.KeyState == PendingImportState),
 		d.Set("default_key_flag", key.DefaultKeyFlag),
 		d.Set("expiration_time", key.ExpirationTime),
 		d.Set("origin", key.Origin),
+		d.Set("key_spec", key.KeySpec),
+		d.Set("key_usage", key.KeyUsage),
 	)
 
 	if mErr.ErrorOrNil() != nil {
 		return mErr
 	}
 
+	switch {
+	case !isSymmetricKeyV1(key.KeySpec):
+		publicKey, err := getPublicKeyV1(client, d.Id())
+		if err != nil {
+			return fmt.Errorf("error fetching public key: %s", err)
+		}
+		mErr = multierror.Append(mErr,
+			d.Set("public_key", publicKey),
+		)
+	case key.Origin != ExternalOrigin:
+		rotation, err := getKeyV1RotationStatus(client, d.Id())
+		if err != nil {
+			return fmt.Errorf("error fetching key rotation status: %s", err)
+		}
+		mErr = multierror.Append(mErr,
+			d.Set("rotation_enabled", rotation.Enabled),
+			d.Set("rotation_interval", rotation.Interval),
+			d.Set("rotation_number", rotation.NumberOfRotations),
+		)
+	}
+	if err := mErr.ErrorOrNil(); err != nil {
+		return err
+	}
+
 	// save tags
 	resourceTags, err := tags.Get(client, "kms", d.Id()).Extract()
 	if err != nil {
@@ -256,6 +355,12 @@ func resourceKmsKeyV1Update(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChanges("rotation_enabled", "rotation_interval") {
+		if err := updateKeyV1Rotation(client, d); err != nil {
+			return err
+		}
+	}
+
 	// update tags
 	if d.HasChange("tags") {
 		if err := common.UpdateResourceTags(client, d, "kms", d.Id()); err != nil {
@@ -317,3 +422,58 @@ func keyV1StateRefreshFunc(client *golangsdk.ServiceClient, keyID string) resour
 		return v, v.KeyState, nil
 	}
 }
+
+// isSymmetricKeyV1 checks if the key spec is the one of symmetric key, keys created before
+// asymmetric keys were introduced have no key spec at all
+func isSymmetricKeyV1(keySpec string) bool {
+	return keySpec == "" || keySpec == SymmetricKeySpec
+}
+
+func updateKeyV1Rotation(client *golangsdk.ServiceClient, d *schema.ResourceData) error {
+	if !d.Get("rotation_enabled").(bool) {
+		if !d.HasChange("rotation_enabled") {
+			return nil
+		}
+		if err := disableKeyV1Rotation(client, d.Id()); err != nil {
+			return fmt.Errorf("error disabling key rotation: %s", err)
+		}
+		return nil
+	}
+
+	if d.HasChange("rotation_enabled") {
+		if err := enableKeyV1Rotation(client, d.Id()); err != nil {
+			return fmt.Errorf("error enabling key rotation: %s", err)
+		}
+	}
+	if v, ok := d.GetOk("rotation_interval"); ok && d.HasChange("rotation_interval") {
+		if err := updateKeyV1RotationInterval(client, d.Id(), v.(int)); err != nil {
+			return fmt.Errorf("error updating key rotation interval: %s", err)
+		}
+	}
+	return nil
+}
+
+func validateKeyV1Rotation(d *schema.ResourceDiff, _ interface{}) error {
+	if !d.Get("rotation_enabled").(bool) {
+		return nil
+	}
+	if keySpec := d.Get("key_spec").(string); !isSymmetricKeyV1(keySpec) {
+		return fmt.Errorf("rotation is supported only for %s keys, got %s", SymmetricKeySpec, keySpec)
+	}
+	if d.Get("origin").(string) == ExternalOrigin {
+		return fmt.Errorf("rotation is not supported for keys with %s origin", ExternalOrigin)
+	}
+	return nil
+}
+
+// validateKeyV1ExternalState rejects creating disabled external keys: key without key material
+// can't be disabled, key material is imported after the key is created.
+func validateKeyV1ExternalState(d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() != "" || d.Get("is_enabled").(bool) {
+		return nil
+	}
+	if d.Get("origin").(string) == ExternalOrigin {
+		return fmt.Errorf("key with %s origin can't be created disabled, set `is_enabled` to false after the key material import", ExternalOrigin)
+	}
+	return nil
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/eps"
 )
 
 func ResourceNatGatewayV2() *schema.Resource {
@@ -67,6 +68,11 @@ func ResourceNatGatewayV2() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -78,13 +84,16 @@ func resourceNatGatewayV2Create(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("Error creating OpenTelekomCloud nat client: %s", err)
 	}
 
-	createOpts := &natgateways.CreateOpts{
-		Name:              d.Get("name").(string),
-		Description:       d.Get("description").(string),
-		Spec:              d.Get("spec").(string),
-		TenantID:          d.Get("tenant_id").(string),
-		RouterID:          d.Get("router_id").(string),
-		InternalNetworkID: d.Get("internal_network_id").(string),
+	createOpts := GatewayCreateOpts{
+		CreateOpts: natgateways.CreateOpts{
+			Name:              d.Get("name").(string),
+			Description:       d.Get("description").(string),
+			Spec:              d.Get("spec").(string),
+			TenantID:          d.Get("tenant_id").(string),
+			RouterID:          d.Get("router_id").(string),
+			InternalNetworkID: d.Get("internal_network_id").(string),
+		},
+		EnterpriseProjectID: d.Get("enterprise_project_id").(string),
 	}
 
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
@@ -120,10 +129,15 @@ func resourceNatGatewayV2Read(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating OpenTelekomCloud nat client: %s", err)
 	}
 
-	natGateway, err := natgateways.Get(NatV2Client, d.Id()).Extract()
+	result := natgateways.Get(NatV2Client, d.Id())
+	natGateway, err := result.Extract()
 	if err != nil {
 		return common.CheckDeleted(d, err, "Nat Gateway")
 	}
+	epsID, err := eps.ExtractEnterpriseProjectID(result, "nat_gateway")
+	if err != nil {
+		return fmt.Errorf("error extracting enterprise project ID of NAT gateway: %s", err)
+	}
 
 	d.Set("name", natGateway.Name)
 	d.Set("description", natGateway.Description)
@@ -131,6 +145,7 @@ func resourceNatGatewayV2Read(d *schema.ResourceData, meta interface{}) error {
 	d.Set("router_id", natGateway.RouterID)
 	d.Set("internal_network_id", natGateway.InternalNetworkID)
 	d.Set("tenant_id", natGateway.TenantID)
+	d.Set("enterprise_project_id", epsID)
 
 	d.Set("region", config.GetRegion(d))
 
@@ -144,23 +159,33 @@ func resourceNatGatewayV2Update(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("Error creating OpenTelekomCloud nat client: %s", err)
 	}
 
-	var updateOpts natgateways.UpdateOpts
+	if d.HasChanges("name", "description", "spec") {
+		var updateOpts natgateways.UpdateOpts
 
-	if d.HasChange("name") {
-		updateOpts.Name = d.Get("name").(string)
-	}
-	if d.HasChange("description") {
-		updateOpts.Description = d.Get("description").(string)
-	}
-	if d.HasChange("spec") {
-		updateOpts.Spec = d.Get("spec").(string)
-	}
+		if d.HasChange("name") {
+			updateOpts.Name = d.Get("name").(string)
+		}
+		if d.HasChange("description") {
+			updateOpts.Description = d.Get("description").(string)
+		}
+		if d.HasChange("spec") {
+			updateOpts.Spec = d.Get("spec").(string)
+		}
 
-	log.Printf("[DEBUG] Update Options: %#v", updateOpts)
+		log.Printf("[DEBUG] Update Options: %#v", updateOpts)
 
-	_, err = natgateways.Update(NatV2Client, d.Id(), updateOpts).Extract()
-	if err != nil {
-		return fmt.Errorf("Error updating Nat Gateway: %s", err)
+		_, err = natgateways.Update(NatV2Client, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating Nat Gateway: %s", err)
+		}
+	}
+
+	migrateOpts := eps.MigrateResourceOpts{
+		ResourceType: "nat_gateways",
+		ResourceID:   d.Id(),
+	}
+	if err := eps.MigrateResource(d, config, migrateOpts); err != nil {
+		return err
 	}
 
 	return resourceNatGatewayV2Read(d, meta)
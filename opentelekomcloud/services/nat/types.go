@@ -0,0 +1,18 @@
+package nat
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/natgateways"
+)
+
+// GatewayCreateOpts represents the attributes used when creating a new NAT gateway.
+type GatewayCreateOpts struct {
+	natgateways.CreateOpts
+	EnterpriseProjectID string `json:"enterprise_project_id,omitempty"`
+}
+
+// ToNatGatewayCreateMap casts a CreateOpts struct to a map.
+// It overrides natgateways.ToNatGatewayCreateMap to add the EnterpriseProjectID field.
+func (opts GatewayCreateOpts) ToNatGatewayCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "nat_gateway")
+}
@@ -12,7 +12,6 @@ import (
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/obs"
 
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
-	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/s3"
 )
 
 func DataSourceObsBucketObject() *schema.Resource {
@@ -149,7 +148,7 @@ func dataSourceObsBucketObjectRead(d *schema.ResourceData, meta interface{}) err
 		return err
 	}
 
-	if s3.IsContentTypeAllowed(&out.ContentType) {
+	if IsContentTypeAllowed(&out.ContentType) {
 		input := &obs.GetObjectInput{
 			GetObjectMetadataInput: obs.GetObjectMetadataInput{
 				Bucket: bucket,
@@ -0,0 +1,170 @@
+package obs
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/obs"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func DataSourceObsBuckets() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceObsBucketsRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"bucket": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"storage_class": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"STANDARD", "WARM", "COLD",
+				}, false),
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			"buckets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"storage_class": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeMap,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getObsBucketTags(obsClient *obs.ObsClient, bucket string) (map[string]string, error) {
+	tags := make(map[string]string)
+	output, err := obsClient.GetBucketTagging(bucket)
+	if err != nil {
+		if obsError, ok := err.(obs.ObsError); ok && obsError.Code == "NoSuchTagSet" {
+			return tags, nil
+		}
+		return nil, GetObsError("error getting tags of OBS bucket", bucket, err)
+	}
+	for _, tag := range output.Tags {
+		tags[tag.Key] = tag.Value
+	}
+	return tags, nil
+}
+
+func dataSourceObsBucketsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	region := config.GetRegion(d)
+	obsClient, err := config.NewObjectStorageClient(region)
+	if err != nil {
+		return fmt.Errorf("error creating OBS client: %s", err)
+	}
+
+	output, err := obsClient.ListBuckets(&obs.ListBucketsInput{QueryLocation: true})
+	if err != nil {
+		return fmt.Errorf("error listing OBS buckets: %s", err)
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+	name := d.Get("bucket").(string)
+	class := d.Get("storage_class").(string)
+	filterTags := d.Get("tags").(map[string]interface{})
+
+	var names []string
+	var buckets []map[string]interface{}
+	for _, bucket := range output.Buckets {
+		if bucket.Location != region {
+			continue
+		}
+		if name != "" && bucket.Name != name {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(bucket.Name) {
+			continue
+		}
+
+		policy, err := obsClient.GetBucketStoragePolicy(bucket.Name)
+		if err != nil {
+			return GetObsError("error getting storage class of OBS bucket", bucket.Name, err)
+		}
+		storageClass := normalizeStorageClass(string(policy.StorageClass))
+		if class != "" && storageClass != class {
+			continue
+		}
+
+		tags, err := getObsBucketTags(obsClient, bucket.Name)
+		if err != nil {
+			return err
+		}
+		matched := true
+		for key, value := range filterTags {
+			if tags[key] != value.(string) {
+				matched = false
+				break
+			}
+		}
+		if !matched {
+			continue
+		}
+
+		names = append(names, bucket.Name)
+		buckets = append(buckets, map[string]interface{}{
+			"bucket":        bucket.Name,
+			"region":        bucket.Location,
+			"storage_class": storageClass,
+			"created_at":    bucket.CreationDate.Format(time.RFC3339),
+			"tags":          tags,
+		})
+	}
+	log.Printf("[DEBUG] Found %d OBS buckets", len(buckets))
+
+	d.SetId(hashcode.Strings(names))
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("buckets", buckets),
+	)
+	return mErr.ErrorOrNil()
+}
@@ -0,0 +1,218 @@
+package obs
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/obs"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func DataSourceS3BucketObject() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceS3BucketObjectRead,
+
+		Schema: map[string]*schema.Schema{
+			"body": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"cache_control": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content_disposition": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content_encoding": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content_language": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content_length": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"content_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expiration": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expires": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"last_modified": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"metadata": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+			"range": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"server_side_encryption": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sse_kms_key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"website_redirect_location": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+var s3RangeRegexp = regexp.MustCompile(`^bytes=(\d+)-(\d+)$`)
+
+// parseS3Range parses `bytes=start-end` range header value
+func parseS3Range(value string) (int64, int64, error) {
+	parts := s3RangeRegexp.FindStringSubmatch(value)
+	if parts == nil {
+		return 0, 0, fmt.Errorf("invalid range %q, expected format is `bytes=start-end`", value)
+	}
+	start, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	end, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	return start, end, nil
+}
+
+func dataSourceS3BucketObjectRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.NewObjectStorageClient(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OBS client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+
+	input := obs.GetObjectMetadataInput{
+		Bucket: bucket,
+		Key:    key,
+	}
+	uniqueId := bucket + "/" + key
+	if v, ok := d.GetOk("version_id"); ok {
+		input.VersionId = v.(string)
+		uniqueId += "@" + v.(string)
+	}
+
+	log.Printf("[DEBUG] Reading S3 object: %#v", input)
+	out, err := client.GetObjectMetadata(&input)
+	if err != nil {
+		return fmt.Errorf("failed getting S3 object: %s Bucket: %q Object: %q", err, bucket, key)
+	}
+	log.Printf("[DEBUG] Received S3 object: %#v", out)
+
+	d.SetId(uniqueId)
+
+	var encryption, kmsKeyID string
+	if sseHeader, ok := out.SseHeader.(obs.SseKmsHeader); ok {
+		encryption = sseHeader.Encryption
+		kmsKeyID = sseHeader.Key
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("cache_control", responseHeader(out.ResponseHeaders, "cache-control")),
+		d.Set("content_disposition", responseHeader(out.ResponseHeaders, "content-disposition")),
+		d.Set("content_encoding", responseHeader(out.ResponseHeaders, "content-encoding")),
+		d.Set("content_language", responseHeader(out.ResponseHeaders, "content-language")),
+		d.Set("content_length", out.ContentLength),
+		d.Set("content_type", out.ContentType),
+		d.Set("etag", strings.Trim(out.ETag, `"`)),
+		d.Set("expiration", out.Expiration),
+		d.Set("expires", responseHeader(out.ResponseHeaders, "expires")),
+		d.Set("last_modified", out.LastModified.Format(time.RFC1123)),
+		d.Set("metadata", out.Metadata),
+		d.Set("server_side_encryption", encryption),
+		d.Set("sse_kms_key_id", kmsKeyID),
+		d.Set("version_id", out.VersionId),
+		d.Set("website_redirect_location", out.WebsiteRedirectLocation),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return err
+	}
+
+	if !IsContentTypeAllowed(&out.ContentType) {
+		contentType := out.ContentType
+		if contentType == "" {
+			contentType = "<EMPTY>"
+		}
+		log.Printf("[INFO] Ignoring body of S3 object %s with Content-Type %q", uniqueId, contentType)
+		return nil
+	}
+
+	getInput := &obs.GetObjectInput{
+		GetObjectMetadataInput: obs.GetObjectMetadataInput{
+			Bucket:    bucket,
+			Key:       key,
+			VersionId: out.VersionId,
+		},
+	}
+	if v, ok := d.GetOk("range"); ok {
+		getInput.RangeStart, getInput.RangeEnd, err = parseS3Range(v.(string))
+		if err != nil {
+			return err
+		}
+	}
+	obj, err := client.GetObject(getInput)
+	if err != nil {
+		return fmt.Errorf("failed getting S3 object: %s", err)
+	}
+	defer obj.Body.Close()
+	if obj.DeleteMarker {
+		return fmt.Errorf("requested S3 object %q has been deleted", uniqueId)
+	}
+
+	buf := new(bytes.Buffer)
+	bytesRead, err := buf.ReadFrom(obj.Body)
+	if err != nil {
+		return fmt.Errorf("failed reading content of S3 object (%s): %s", uniqueId, err)
+	}
+	log.Printf("[INFO] Saving %d bytes from S3 object %s", bytesRead, uniqueId)
+	return d.Set("body", buf.String())
+}
@@ -0,0 +1,38 @@
+package obs
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/obs"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func resourceS3BucketImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	results := []*schema.ResourceData{d}
+
+	config := meta.(*cfg.Config)
+	client, err := config.NewObjectStorageClient(config.GetRegion(d))
+	if err != nil {
+		return nil, fmt.Errorf("error creating OBS client: %s", err)
+	}
+	pol, err := client.GetBucketPolicy(d.Id())
+	if err != nil {
+		if obsError, ok := err.(obs.ObsError); ok && obsError.Code == "NoSuchBucketPolicy" {
+			// Bucket without policy
+			return results, nil
+		}
+		return nil, GetObsError("error importing policy of S3 bucket", d.Id(), err)
+	}
+
+	policy := ResourceS3BucketPolicy()
+	pData := policy.Data(nil)
+	pData.SetId(d.Id())
+	pData.SetType("opentelekomcloud_s3_bucket_policy")
+	_ = pData.Set("bucket", d.Id())
+	_ = pData.Set("policy", pol.Policy)
+	results = append(results, pData)
+
+	return results, nil
+}
@@ -0,0 +1,249 @@
+package obs
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/obs"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const (
+	subResourceEncryption  obs.SubResourceType = "encryption"
+	subResourceReplication obs.SubResourceType = "replication"
+	subResourceObjectLock  obs.SubResourceType = "object-lock"
+)
+
+// BucketEncryptionConfiguration represents default server-side encryption of the bucket.
+type BucketEncryptionConfiguration struct {
+	XMLName      xml.Name `xml:"ServerSideEncryptionConfiguration"`
+	SSEAlgorithm string   `xml:"Rule>ApplyServerSideEncryptionByDefault>SSEAlgorithm"`
+	KMSKeyID     string   `xml:"Rule>ApplyServerSideEncryptionByDefault>KMSMasterKeyID,omitempty"`
+}
+
+// BucketReplicationConfiguration represents cross-region replication configuration of the bucket.
+type BucketReplicationConfiguration struct {
+	XMLName xml.Name                `xml:"ReplicationConfiguration"`
+	Agency  string                  `xml:"Agency"`
+	Rules   []BucketReplicationRule `xml:"Rule"`
+}
+
+// BucketReplicationRule represents a single replication rule.
+type BucketReplicationRule struct {
+	ID           string `xml:"ID,omitempty"`
+	Status       string `xml:"Status"`
+	Prefix       string `xml:"Prefix"`
+	Bucket       string `xml:"Destination>Bucket"`
+	StorageClass string `xml:"Destination>StorageClass,omitempty"`
+}
+
+// BucketObjectLockConfiguration represents WORM configuration of the bucket.
+type BucketObjectLockConfiguration struct {
+	XMLName           xml.Name                `xml:"ObjectLockConfiguration"`
+	ObjectLockEnabled string                  `xml:"ObjectLockEnabled,omitempty"`
+	DefaultRetention  *BucketDefaultRetention `xml:"Rule>DefaultRetention"`
+}
+
+// BucketDefaultRetention represents default retention period of the bucket objects.
+type BucketDefaultRetention struct {
+	Mode  string `xml:"Mode"`
+	Days  int    `xml:"Days,omitempty"`
+	Years int    `xml:"Years,omitempty"`
+}
+
+// CreateBucketOpts contains the options of the bucket which can't be set using the SDK.
+type CreateBucketOpts struct {
+	Bucket              string
+	Location            string
+	ParallelFS          bool
+	ObjectLockEnabled   bool
+	EnterpriseProjectID string
+}
+
+// bucketConfigurationClient returns OBS client using V4 signature, as bucket
+// sub-resources missing in the SDK are not covered by the V2 signature
+func bucketConfigurationClient(config *cfg.Config, region string) (*obs.ObsClient, error) {
+	return config.NewObjectStorageClient(region, obs.WithSignature(obs.SignatureV4), obs.WithRegion(region))
+}
+
+// doBucketRequest executes the signed request to the bucket, `data` is sent as the request body
+// and the XML response is unmarshalled into `result` when it's not `nil`
+func doBucketRequest(config *cfg.Config, client *obs.ObsClient, input *obs.CreateSignedUrlInput, data []byte, result interface{}) error {
+	signed, err := client.CreateSignedUrl(input)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(string(input.Method), signed.SignedUrl, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	for key, values := range signed.ActualSignedRequestHeaders {
+		if http.CanonicalHeaderKey(key) == "Host" {
+			continue
+		}
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+
+	resp, err := config.HwClient.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode >= 300 {
+		obsError := obs.ObsError{}
+		obsError.StatusCode = resp.StatusCode
+		obsError.Status = resp.Status
+		if len(respBody) > 0 {
+			_ = xml.Unmarshal(respBody, &obsError)
+		}
+		return obsError
+	}
+	if result != nil && len(respBody) > 0 {
+		if err := xml.Unmarshal(respBody, result); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// doBucketConfigurationRequest executes the request for the bucket sub-resource, `body` is sent
+// and the response is unmarshalled into `result` when they are not `nil`
+func doBucketConfigurationRequest(config *cfg.Config, client *obs.ObsClient, method obs.HttpMethodType, bucket string,
+	subResource obs.SubResourceType, body interface{}, result interface{}) error {
+	input := &obs.CreateSignedUrlInput{
+		Method:      method,
+		Bucket:      bucket,
+		SubResource: subResource,
+		Headers:     make(map[string]string),
+	}
+	var data []byte
+	if body != nil {
+		var err error
+		data, err = xml.Marshal(body)
+		if err != nil {
+			return err
+		}
+		sum := md5.Sum(data)
+		input.Headers["Content-MD5"] = base64.StdEncoding.EncodeToString(sum[:])
+		input.Headers["Content-Type"] = "application/xml"
+	}
+	return doBucketRequest(config, client, input, data, result)
+}
+
+// isBucketConfigurationNotFound checks if the error means that the bucket configuration is not set
+func isBucketConfigurationNotFound(err error) bool {
+	if obsError, ok := err.(obs.ObsError); ok {
+		return obsError.StatusCode == http.StatusNotFound
+	}
+	return false
+}
+
+func getBucketEncryption(config *cfg.Config, client *obs.ObsClient, bucket string) (*BucketEncryptionConfiguration, error) {
+	var res BucketEncryptionConfiguration
+	err := doBucketConfigurationRequest(config, client, obs.HTTP_GET, bucket, subResourceEncryption, nil, &res)
+	if err != nil {
+		if isBucketConfigurationNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &res, nil
+}
+
+func setBucketEncryption(config *cfg.Config, client *obs.ObsClient, bucket string, opts BucketEncryptionConfiguration) error {
+	return doBucketConfigurationRequest(config, client, obs.HTTP_PUT, bucket, subResourceEncryption, opts, nil)
+}
+
+func deleteBucketEncryption(config *cfg.Config, client *obs.ObsClient, bucket string) error {
+	err := doBucketConfigurationRequest(config, client, obs.HTTP_DELETE, bucket, subResourceEncryption, nil, nil)
+	if isBucketConfigurationNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func getBucketReplication(config *cfg.Config, client *obs.ObsClient, bucket string) (*BucketReplicationConfiguration, error) {
+	var res BucketReplicationConfiguration
+	err := doBucketConfigurationRequest(config, client, obs.HTTP_GET, bucket, subResourceReplication, nil, &res)
+	if err != nil {
+		if isBucketConfigurationNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &res, nil
+}
+
+func setBucketReplication(config *cfg.Config, client *obs.ObsClient, bucket string, opts BucketReplicationConfiguration) error {
+	if len(opts.Rules) == 0 {
+		return fmt.Errorf("at least one replication rule is required")
+	}
+	return doBucketConfigurationRequest(config, client, obs.HTTP_PUT, bucket, subResourceReplication, opts, nil)
+}
+
+func deleteBucketReplication(config *cfg.Config, client *obs.ObsClient, bucket string) error {
+	err := doBucketConfigurationRequest(config, client, obs.HTTP_DELETE, bucket, subResourceReplication, nil, nil)
+	if isBucketConfigurationNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// createBucket creates the bucket with the options missing in the SDK,
+// ACL and storage class should be set separately
+func createBucket(config *cfg.Config, client *obs.ObsClient, opts CreateBucketOpts) error {
+	input := &obs.CreateSignedUrlInput{
+		Method:  obs.HTTP_PUT,
+		Bucket:  opts.Bucket,
+		Headers: make(map[string]string),
+	}
+	if opts.ParallelFS {
+		input.Headers["x-obs-fs-file-interface"] = "Enabled"
+	}
+	if opts.ObjectLockEnabled {
+		input.Headers["x-obs-bucket-object-lock-enabled"] = "true"
+	}
+	if opts.EnterpriseProjectID != "" {
+		input.Headers["x-obs-epid"] = opts.EnterpriseProjectID
+	}
+	var data []byte
+	if opts.Location != "" {
+		var err error
+		data, err = xml.Marshal(obs.BucketLocation{Location: opts.Location})
+		if err != nil {
+			return err
+		}
+		input.Headers["Content-Type"] = "application/xml"
+	}
+	return doBucketRequest(config, client, input, data, nil)
+}
+
+func getBucketObjectLock(config *cfg.Config, client *obs.ObsClient, bucket string) (*BucketObjectLockConfiguration, error) {
+	var res BucketObjectLockConfiguration
+	err := doBucketConfigurationRequest(config, client, obs.HTTP_GET, bucket, subResourceObjectLock, nil, &res)
+	if err != nil {
+		if isBucketConfigurationNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &res, nil
+}
+
+func setBucketObjectLock(config *cfg.Config, client *obs.ObsClient, bucket string, opts BucketObjectLockConfiguration) error {
+	return doBucketConfigurationRequest(config, client, obs.HTTP_PUT, bucket, subResourceObjectLock, opts, nil)
+}
@@ -0,0 +1,114 @@
+package obs
+
+import (
+	"io"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/obs"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+)
+
+// objectUploader implements common.MultipartUploader for OBS objects
+type objectUploader struct {
+	client *obs.ObsClient
+	input  obs.PutObjectBasicInput
+}
+
+func (u *objectUploader) FindUpload() (string, error) {
+	input := &obs.ListMultipartUploadsInput{
+		Bucket: u.input.Bucket,
+		Prefix: u.input.Key,
+	}
+	uploadID := ""
+	for {
+		output, err := u.client.ListMultipartUploads(input)
+		if err != nil {
+			return "", err
+		}
+		// uploads are sorted by the initiation time, so the last one is used
+		for _, upload := range output.Uploads {
+			if upload.Key == u.input.Key {
+				uploadID = upload.UploadId
+			}
+		}
+		if !output.IsTruncated {
+			break
+		}
+		input.KeyMarker = output.NextKeyMarker
+		input.UploadIdMarker = output.NextUploadIdMarker
+	}
+	return uploadID, nil
+}
+
+func (u *objectUploader) InitiateUpload() (string, error) {
+	output, err := u.client.InitiateMultipartUpload(&obs.InitiateMultipartUploadInput{
+		ObjectOperationInput: u.input.ObjectOperationInput,
+		ContentType:          u.input.ContentType,
+	})
+	if err != nil {
+		return "", err
+	}
+	return output.UploadId, nil
+}
+
+func (u *objectUploader) ListParts(uploadID string) (map[int]string, error) {
+	input := &obs.ListPartsInput{
+		Bucket:   u.input.Bucket,
+		Key:      u.input.Key,
+		UploadId: uploadID,
+	}
+	parts := make(map[int]string)
+	for {
+		output, err := u.client.ListParts(input)
+		if err != nil {
+			return nil, err
+		}
+		for _, part := range output.Parts {
+			parts[part.PartNumber] = part.ETag
+		}
+		if !output.IsTruncated {
+			break
+		}
+		input.PartNumberMarker = output.NextPartNumberMarker
+	}
+	return parts, nil
+}
+
+func (u *objectUploader) UploadPart(uploadID string, partNumber int, body io.ReadSeeker, size int64, contentMD5 string) (string, error) {
+	output, err := u.client.UploadPart(&obs.UploadPartInput{
+		Bucket:     u.input.Bucket,
+		Key:        u.input.Key,
+		PartNumber: partNumber,
+		UploadId:   uploadID,
+		ContentMD5: contentMD5,
+		SseHeader:  u.input.SseHeader,
+		Body:       body,
+		PartSize:   size,
+	})
+	if err != nil {
+		return "", err
+	}
+	return output.ETag, nil
+}
+
+func (u *objectUploader) CompleteUpload(uploadID string, parts []common.UploadedPart) (*common.UploadResult, error) {
+	input := &obs.CompleteMultipartUploadInput{
+		Bucket:   u.input.Bucket,
+		Key:      u.input.Key,
+		UploadId: uploadID,
+	}
+	for _, part := range parts {
+		input.Parts = append(input.Parts, obs.Part{
+			PartNumber: part.PartNumber,
+			ETag:       part.ETag,
+		})
+	}
+	output, err := u.client.CompleteMultipartUpload(input)
+	if err != nil {
+		return nil, err
+	}
+	return &common.UploadResult{
+		ETag:      output.ETag,
+		VersionID: output.VersionId,
+	}, nil
+}
@@ -14,7 +14,7 @@ import (
 
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
-	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/s3"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/eps"
 )
 
 func ResourceObsBucket() *schema.Resource {
@@ -27,6 +27,8 @@ func ResourceObsBucket() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: checkObsBucketWormPolicy,
+
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Type:     schema.TypeString,
@@ -232,6 +234,184 @@ func ResourceObsBucket() *schema.Resource {
 				},
 			},
 
+			"server_side_encryption": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"algorithm": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"kms", "AES256",
+							}, false),
+						},
+						"kms_key_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"replication": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"agency": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"rule": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+									"prefix": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+									"destination_bucket": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"storage_class": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											"STANDARD", "WARM", "COLD",
+										}, false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"event_notifications": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"topic": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"events": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"filter_rule": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											"prefix", "suffix",
+										}, false),
+									},
+									"value": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"quota": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"parallel_fs": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
+			"worm_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mode": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "COMPLIANCE",
+							ValidateFunc: validation.StringInSlice([]string{
+								"COMPLIANCE",
+							}, false),
+						},
+						"days": {
+							Type:          schema.TypeInt,
+							Optional:      true,
+							ValidateFunc:  validation.IntBetween(1, 36500),
+							ConflictsWith: []string{"worm_policy.0.years"},
+						},
+						"years": {
+							Type:          schema.TypeInt,
+							Optional:      true,
+							ValidateFunc:  validation.IntBetween(1, 100),
+							ConflictsWith: []string{"worm_policy.0.days"},
+						},
+					},
+				},
+			},
+
+			"object_lock_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"storage_info": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"object_number": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"tags": {
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -253,6 +433,12 @@ func ResourceObsBucket() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -273,11 +459,21 @@ func resourceObsBucketCreate(d *schema.ResourceData, meta interface{}) error {
 		StorageClass: obs.StorageClassType(class),
 	}
 	opts.Location = d.Get("region").(string)
-	log.Printf("[DEBUG] OBS bucket create opts: %#v", opts)
+	opts.Epid = d.Get("enterprise_project_id").(string)
 
-	_, err = client.CreateBucket(opts)
-	if err != nil {
-		return GetObsError("Error creating bucket", bucket, err)
+	parallelFS := d.Get("parallel_fs").(bool)
+	_, wormEnabled := d.GetOk("worm_policy")
+	if parallelFS || wormEnabled {
+		// the SDK can't create parallel file systems and buckets with object lock
+		if err := resourceObsBucketCreateExtended(config, d, opts, parallelFS, wormEnabled); err != nil {
+			return err
+		}
+	} else {
+		log.Printf("[DEBUG] OBS bucket create opts: %#v", opts)
+		_, err = client.CreateBucket(opts)
+		if err != nil {
+			return GetObsError("Error creating bucket", bucket, err)
+		}
 	}
 
 	// Assign the bucket name as the resource ID
@@ -285,6 +481,47 @@ func resourceObsBucketCreate(d *schema.ResourceData, meta interface{}) error {
 	return resourceObsBucketUpdate(d, meta)
 }
 
+func resourceObsBucketCreateExtended(config *cfg.Config, d *schema.ResourceData, opts *obs.CreateBucketInput, parallelFS, wormEnabled bool) error {
+	client, err := bucketConfigurationClient(config, config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OBS client: %s", err)
+	}
+
+	createOpts := CreateBucketOpts{
+		Bucket:              opts.Bucket,
+		Location:            opts.Location,
+		ParallelFS:          parallelFS,
+		ObjectLockEnabled:   wormEnabled,
+		EnterpriseProjectID: opts.Epid,
+	}
+	log.Printf("[DEBUG] OBS bucket create opts: %#v", createOpts)
+	if err := createBucket(config, client, createOpts); err != nil {
+		return GetObsError("Error creating bucket", opts.Bucket, err)
+	}
+
+	obsClient, err := config.NewObjectStorageClient(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OBS client: %s", err)
+	}
+	if err := resourceObsBucketAclUpdate(obsClient, d); err != nil {
+		return err
+	}
+	return resourceObsBucketClassUpdate(obsClient, d)
+}
+
+// checkObsBucketWormPolicy recreates the bucket if WORM is enabled for the existing bucket without object lock,
+// as object lock can only be enabled during the bucket creation
+func checkObsBucketWormPolicy(d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.HasChange("worm_policy") || d.Get("object_lock_enabled").(bool) {
+		return nil
+	}
+	oldPolicy, newPolicy := d.GetChange("worm_policy")
+	if len(oldPolicy.([]interface{})) == 0 && len(newPolicy.([]interface{})) != 0 {
+		return d.ForceNew("worm_policy")
+	}
+	return nil
+}
+
 func resourceObsBucketUpdate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*cfg.Config)
 	obsClient, err := config.NewObjectStorageClient(config.GetRegion(d))
@@ -341,6 +578,55 @@ func resourceObsBucketUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChanges("server_side_encryption", "replication") {
+		client, err := bucketConfigurationClient(config, config.GetRegion(d))
+		if err != nil {
+			return fmt.Errorf("error creating OBS client: %s", err)
+		}
+		if d.HasChange("server_side_encryption") {
+			if err := resourceObsBucketEncryptionUpdate(config, client, d); err != nil {
+				return err
+			}
+		}
+		if d.HasChange("replication") {
+			if err := resourceObsBucketReplicationUpdate(config, client, d); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("quota") {
+		if err := resourceObsBucketQuotaUpdate(obsClient, d); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("worm_policy") {
+		client, err := bucketConfigurationClient(config, config.GetRegion(d))
+		if err != nil {
+			return fmt.Errorf("error creating OBS client: %s", err)
+		}
+		if err := resourceObsBucketWormUpdate(config, client, d); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("event_notifications") {
+		if err := resourceObsBucketNotificationsUpdate(obsClient, d); err != nil {
+			return err
+		}
+	}
+
+	if !d.IsNewResource() {
+		migrateOpts := eps.MigrateResourceOpts{
+			ResourceType: "bucket",
+			ResourceID:   d.Id(),
+		}
+		if err := eps.MigrateResource(d, config, migrateOpts); err != nil {
+			return err
+		}
+	}
+
 	return resourceObsBucketRead(d, meta)
 }
 
@@ -353,7 +639,7 @@ func resourceObsBucketRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	log.Printf("[DEBUG] Read OBS bucket: %s", d.Id())
-	_, err = obsClient.HeadBucket(d.Id())
+	head, err := obsClient.HeadBucket(d.Id())
 	if err != nil {
 		if obsError, ok := err.(obs.ObsError); ok && obsError.StatusCode == 404 {
 			log.Printf("[WARN] OBS bucket(%s) not found", d.Id())
@@ -371,9 +657,20 @@ func resourceObsBucketRead(d *schema.ResourceData, meta interface{}) error {
 		mErr = multierror.Append(mErr, d.Set("bucket", d.Id()))
 	}
 
+	parallelFS := false
+	if fsInterface, ok := head.ResponseHeaders["fs-file-interface"]; ok && len(fsInterface) > 0 {
+		parallelFS = fsInterface[0] == "Enabled"
+	}
+	epsID := ""
+	if epid, ok := head.ResponseHeaders[obs.HEADER_EPID_HEADERS]; ok && len(epid) > 0 {
+		epsID = epid[0]
+	}
+
 	mErr = multierror.Append(mErr,
 		d.Set("region", region),
-		d.Set("bucket_domain_name", s3.BucketDomainName(d.Get("bucket").(string), region)),
+		d.Set("bucket_domain_name", BucketDomainName(d.Get("bucket").(string), region)),
+		d.Set("parallel_fs", parallelFS),
+		d.Set("enterprise_project_id", epsID),
 	)
 
 	if err := mErr.ErrorOrNil(); err != nil {
@@ -414,6 +711,41 @@ func resourceObsBucketRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	// Read the quota
+	if err := setObsBucketQuota(obsClient, d); err != nil {
+		return err
+	}
+
+	// Read the storage info
+	if err := setObsBucketStorageInfo(obsClient, d); err != nil {
+		return err
+	}
+
+	// Read the event notifications
+	if err := setObsBucketNotifications(obsClient, d); err != nil {
+		return err
+	}
+
+	client, err := bucketConfigurationClient(config, region)
+	if err != nil {
+		return fmt.Errorf("error creating OBS client: %s", err)
+	}
+
+	// Read the default encryption
+	if err := setObsBucketEncryption(config, client, d); err != nil {
+		return err
+	}
+
+	// Read the replication configuration
+	if err := setObsBucketReplication(config, client, d); err != nil {
+		return err
+	}
+
+	// Read the WORM policy
+	if err := setObsBucketWormPolicy(config, client, d); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -827,6 +1159,133 @@ func resourceObsBucketWebsiteDelete(obsClient *obs.ObsClient, d *schema.Resource
 	return nil
 }
 
+func resourceObsBucketEncryptionUpdate(config *cfg.Config, client *obs.ObsClient, d *schema.ResourceData) error {
+	bucket := d.Get("bucket").(string)
+	rawEncryption := d.Get("server_side_encryption").([]interface{})
+	if len(rawEncryption) == 0 {
+		log.Printf("[DEBUG] delete default encryption of OBS bucket %s", bucket)
+		if err := deleteBucketEncryption(config, client, bucket); err != nil {
+			return GetObsError("error deleting default encryption of OBS bucket", bucket, err)
+		}
+		return nil
+	}
+
+	encryption := rawEncryption[0].(map[string]interface{})
+	opts := BucketEncryptionConfiguration{
+		SSEAlgorithm: encryption["algorithm"].(string),
+		KMSKeyID:     encryption["kms_key_id"].(string),
+	}
+	log.Printf("[DEBUG] set default encryption of OBS bucket %s: %#v", bucket, opts)
+	if err := setBucketEncryption(config, client, bucket, opts); err != nil {
+		return GetObsError("error setting default encryption of OBS bucket", bucket, err)
+	}
+	return nil
+}
+
+func resourceObsBucketReplicationUpdate(config *cfg.Config, client *obs.ObsClient, d *schema.ResourceData) error {
+	bucket := d.Get("bucket").(string)
+	rawReplication := d.Get("replication").([]interface{})
+	if len(rawReplication) == 0 {
+		log.Printf("[DEBUG] delete replication configuration of OBS bucket %s", bucket)
+		if err := deleteBucketReplication(config, client, bucket); err != nil {
+			return GetObsError("error deleting replication configuration of OBS bucket", bucket, err)
+		}
+		return nil
+	}
+
+	replication := rawReplication[0].(map[string]interface{})
+	opts := BucketReplicationConfiguration{
+		Agency: replication["agency"].(string),
+	}
+	for _, raw := range replication["rule"].([]interface{}) {
+		rule := raw.(map[string]interface{})
+		status := "Disabled"
+		if rule["enabled"].(bool) {
+			status = "Enabled"
+		}
+		opts.Rules = append(opts.Rules, BucketReplicationRule{
+			ID:           rule["id"].(string),
+			Status:       status,
+			Prefix:       rule["prefix"].(string),
+			Bucket:       rule["destination_bucket"].(string),
+			StorageClass: rule["storage_class"].(string),
+		})
+	}
+	log.Printf("[DEBUG] set replication configuration of OBS bucket %s: %#v", bucket, opts)
+	if err := setBucketReplication(config, client, bucket, opts); err != nil {
+		return GetObsError("error setting replication configuration of OBS bucket", bucket, err)
+	}
+	return nil
+}
+
+func resourceObsBucketNotificationsUpdate(obsClient *obs.ObsClient, d *schema.ResourceData) error {
+	bucket := d.Get("bucket").(string)
+	input := &obs.SetBucketNotificationInput{
+		Bucket: bucket,
+	}
+	for _, raw := range d.Get("event_notifications").([]interface{}) {
+		notification := raw.(map[string]interface{})
+		topicConfiguration := obs.TopicConfiguration{
+			ID:    notification["id"].(string),
+			Topic: notification["topic"].(string),
+		}
+		for _, event := range notification["events"].(*schema.Set).List() {
+			topicConfiguration.Events = append(topicConfiguration.Events, obs.EventType(event.(string)))
+		}
+		for _, rawRule := range notification["filter_rule"].(*schema.Set).List() {
+			rule := rawRule.(map[string]interface{})
+			topicConfiguration.FilterRules = append(topicConfiguration.FilterRules, obs.FilterRule{
+				Name:  rule["name"].(string),
+				Value: rule["value"].(string),
+			})
+		}
+		input.TopicConfigurations = append(input.TopicConfigurations, topicConfiguration)
+	}
+	log.Printf("[DEBUG] set event notifications of OBS bucket %s: %#v", bucket, input)
+
+	_, err := obsClient.SetBucketNotification(input)
+	if err != nil {
+		return GetObsError("error setting event notifications of OBS bucket", bucket, err)
+	}
+	return nil
+}
+
+func resourceObsBucketQuotaUpdate(obsClient *obs.ObsClient, d *schema.ResourceData) error {
+	bucket := d.Get("bucket").(string)
+	input := &obs.SetBucketQuotaInput{
+		Bucket: bucket,
+	}
+	input.Quota = int64(d.Get("quota").(int))
+	log.Printf("[DEBUG] set quota of OBS bucket %s: %#v", bucket, input)
+
+	_, err := obsClient.SetBucketQuota(input)
+	if err != nil {
+		return GetObsError("error setting quota of OBS bucket", bucket, err)
+	}
+	return nil
+}
+
+func resourceObsBucketWormUpdate(config *cfg.Config, client *obs.ObsClient, d *schema.ResourceData) error {
+	bucket := d.Get("bucket").(string)
+	opts := BucketObjectLockConfiguration{
+		ObjectLockEnabled: "Enabled",
+	}
+	// removing the policy disables default retention only, object lock can't be disabled
+	if rawPolicy := d.Get("worm_policy").([]interface{}); len(rawPolicy) > 0 {
+		policy := rawPolicy[0].(map[string]interface{})
+		opts.DefaultRetention = &BucketDefaultRetention{
+			Mode:  policy["mode"].(string),
+			Days:  policy["days"].(int),
+			Years: policy["years"].(int),
+		}
+	}
+	log.Printf("[DEBUG] set WORM policy of OBS bucket %s: %#v", bucket, opts)
+	if err := setBucketObjectLock(config, client, bucket, opts); err != nil {
+		return GetObsError("error setting WORM policy of OBS bucket", bucket, err)
+	}
+	return nil
+}
+
 func setObsBucketStorageClass(obsClient *obs.ObsClient, d *schema.ResourceData) error {
 	bucket := d.Id()
 	output, err := obsClient.GetBucketStoragePolicy(bucket)
@@ -893,7 +1352,7 @@ func ruleToMap(src obs.LifecycleRule) map[string]interface{} {
 	if days := src.Expiration.Days; days > 0 {
 		expiration := make(map[string]interface{})
 		expiration["days"] = days
-		rule["expiration"] = schema.NewSet(s3.ExpirationHash, []interface{}{expiration})
+		rule["expiration"] = schema.NewSet(ExpirationHash, []interface{}{expiration})
 	}
 	// transition
 	if len(src.Transitions) > 0 {
@@ -911,7 +1370,7 @@ func ruleToMap(src obs.LifecycleRule) map[string]interface{} {
 	if days := src.NoncurrentVersionExpiration.NoncurrentDays; days > 0 {
 		expiration := make(map[string]interface{})
 		expiration["days"] = days
-		rule["noncurrent_version_expiration"] = schema.NewSet(s3.ExpirationHash, []interface{}{expiration})
+		rule["noncurrent_version_expiration"] = schema.NewSet(ExpirationHash, []interface{}{expiration})
 	}
 
 	// noncurrent_version_transition
@@ -1101,6 +1560,148 @@ func setObsBucketTags(obsClient *obs.ObsClient, d *schema.ResourceData) error {
 	return nil
 }
 
+func setObsBucketNotifications(obsClient *obs.ObsClient, d *schema.ResourceData) error {
+	bucket := d.Id()
+	output, err := obsClient.GetBucketNotification(bucket)
+	if err != nil {
+		return GetObsError("error getting event notifications of OBS bucket", bucket, err)
+	}
+
+	notifications := make([]map[string]interface{}, len(output.TopicConfigurations))
+	for i, topicConfiguration := range output.TopicConfigurations {
+		events := make([]string, len(topicConfiguration.Events))
+		for j, event := range topicConfiguration.Events {
+			events[j] = string(event)
+		}
+		rules := make([]map[string]interface{}, len(topicConfiguration.FilterRules))
+		for j, rule := range topicConfiguration.FilterRules {
+			rules[j] = map[string]interface{}{
+				"name":  rule.Name,
+				"value": rule.Value,
+			}
+		}
+		notifications[i] = map[string]interface{}{
+			"id":          topicConfiguration.ID,
+			"topic":       topicConfiguration.Topic,
+			"events":      events,
+			"filter_rule": rules,
+		}
+	}
+
+	if err := d.Set("event_notifications", notifications); err != nil {
+		return fmt.Errorf("error saving event notifications of OBS bucket %s: %s", bucket, err)
+	}
+	return nil
+}
+
+func setObsBucketEncryption(config *cfg.Config, client *obs.ObsClient, d *schema.ResourceData) error {
+	bucket := d.Id()
+	output, err := getBucketEncryption(config, client, bucket)
+	if err != nil {
+		return GetObsError("error getting default encryption of OBS bucket", bucket, err)
+	}
+
+	var encryption []map[string]interface{}
+	if output != nil && output.SSEAlgorithm != "" {
+		encryption = append(encryption, map[string]interface{}{
+			"algorithm":  output.SSEAlgorithm,
+			"kms_key_id": output.KMSKeyID,
+		})
+	}
+	if err := d.Set("server_side_encryption", encryption); err != nil {
+		return fmt.Errorf("error saving default encryption of OBS bucket %s: %s", bucket, err)
+	}
+	return nil
+}
+
+func setObsBucketReplication(config *cfg.Config, client *obs.ObsClient, d *schema.ResourceData) error {
+	bucket := d.Id()
+	output, err := getBucketReplication(config, client, bucket)
+	if err != nil {
+		return GetObsError("error getting replication configuration of OBS bucket", bucket, err)
+	}
+
+	var replication []map[string]interface{}
+	if output != nil && len(output.Rules) > 0 {
+		rules := make([]map[string]interface{}, len(output.Rules))
+		for i, rule := range output.Rules {
+			rules[i] = map[string]interface{}{
+				"id":                 rule.ID,
+				"prefix":             rule.Prefix,
+				"enabled":            rule.Status == "Enabled",
+				"destination_bucket": rule.Bucket,
+				"storage_class":      normalizeStorageClass(rule.StorageClass),
+			}
+		}
+		replication = append(replication, map[string]interface{}{
+			"agency": output.Agency,
+			"rule":   rules,
+		})
+	}
+	if err := d.Set("replication", replication); err != nil {
+		return fmt.Errorf("error saving replication configuration of OBS bucket %s: %s", bucket, err)
+	}
+	return nil
+}
+
+func setObsBucketQuota(obsClient *obs.ObsClient, d *schema.ResourceData) error {
+	bucket := d.Id()
+	output, err := obsClient.GetBucketQuota(bucket)
+	if err != nil {
+		return GetObsError("error getting quota of OBS bucket", bucket, err)
+	}
+
+	if err := d.Set("quota", output.Quota); err != nil {
+		return fmt.Errorf("error saving quota of OBS bucket %s: %s", bucket, err)
+	}
+	return nil
+}
+
+func setObsBucketStorageInfo(obsClient *obs.ObsClient, d *schema.ResourceData) error {
+	bucket := d.Id()
+	output, err := obsClient.GetBucketStorageInfo(bucket)
+	if err != nil {
+		return GetObsError("error getting storage info of OBS bucket", bucket, err)
+	}
+
+	storageInfo := []map[string]interface{}{
+		{
+			"size":          output.Size,
+			"object_number": output.ObjectNumber,
+		},
+	}
+	if err := d.Set("storage_info", storageInfo); err != nil {
+		return fmt.Errorf("error saving storage info of OBS bucket %s: %s", bucket, err)
+	}
+	return nil
+}
+
+func setObsBucketWormPolicy(config *cfg.Config, client *obs.ObsClient, d *schema.ResourceData) error {
+	bucket := d.Id()
+	output, err := getBucketObjectLock(config, client, bucket)
+	if err != nil {
+		return GetObsError("error getting WORM policy of OBS bucket", bucket, err)
+	}
+
+	lockEnabled := output != nil && output.ObjectLockEnabled == "Enabled"
+	if err := d.Set("object_lock_enabled", lockEnabled); err != nil {
+		return fmt.Errorf("error saving object lock status of OBS bucket %s: %s", bucket, err)
+	}
+
+	var policy []map[string]interface{}
+	if output != nil && output.DefaultRetention != nil && output.DefaultRetention.Mode != "" {
+		policy = append(policy, map[string]interface{}{
+			"mode":  output.DefaultRetention.Mode,
+			"days":  output.DefaultRetention.Days,
+			"years": output.DefaultRetention.Years,
+		})
+	}
+	if err := d.Set("worm_policy", policy); err != nil {
+		return fmt.Errorf("error saving WORM policy of OBS bucket %s: %s", bucket, err)
+	}
+	return nil
+}
+
 func deleteAllBucketObjects(obsClient *obs.ObsClient, bucket string) error {
 	listOpts := &obs.ListObjectsInput{
 		Bucket: bucket,
@@ -1184,7 +1785,7 @@ func normalizeWebsiteRoutingRules(w []obs.RoutingRule) (string, error) {
 
 	var cleanRules []map[string]interface{}
 	for _, rule := range rules {
-		cleanRules = append(cleanRules, s3.RemoveNil(rule))
+		cleanRules = append(cleanRules, RemoveNil(rule))
 	}
 
 	withoutNulls, err := json.Marshal(cleanRules)
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/obs"
 
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
 )
 
@@ -19,7 +20,7 @@ func ResourceObsBucketObject() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceObsBucketObjectPut,
 		Read:   resourceObsBucketObjectRead,
-		Update: resourceObsBucketObjectPut,
+		Update: resourceObsBucketObjectUpdate,
 		Delete: resourceObsBucketObjectDelete,
 
 		Schema: map[string]*schema.Schema{
@@ -43,6 +44,28 @@ func ResourceObsBucketObject() *schema.Resource {
 				Optional:     true,
 				AtLeastOneOf: []string{"source"},
 			},
+			"source_hash": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"multipart_threshold": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      common.DefaultMultipartThreshold,
+				ValidateFunc: validation.IntAtLeast(common.MinPartSize),
+			},
+			"part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      common.DefaultPartSize,
+				ValidateFunc: validation.IntAtLeast(common.MinPartSize),
+			},
+			"parallel_parts": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      common.DefaultParallelParts,
+				ValidateFunc: validation.IntBetween(1, 32),
+			},
 			"storage_class": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -72,8 +95,8 @@ func ResourceObsBucketObject() *schema.Resource {
 			},
 			"etag": {
 				Type: schema.TypeString,
-				// This will conflict with server-side-encryption and multi-part upload
-				// if/when it's actually implemented. The Etag then won't match raw-file MD5.
+				// This will conflict with server-side-encryption and multi-part upload,
+				// the Etag then won't match raw-file MD5, `source_hash` should be used instead.
 				Optional: true,
 				Computed: true,
 			},
@@ -90,7 +113,7 @@ func ResourceObsBucketObject() *schema.Resource {
 }
 
 func resourceObsBucketObjectPut(d *schema.ResourceData, meta interface{}) error {
-	var resp *obs.PutObjectOutput
+	var versionID string
 	var err error
 
 	config := meta.(*cfg.Config)
@@ -101,7 +124,7 @@ func resourceObsBucketObjectPut(d *schema.ResourceData, meta interface{}) error
 
 	if source, ok := d.GetOk("source"); ok {
 		// check source file whether exist
-		_, err := os.Stat(source.(string))
+		info, err := os.Stat(source.(string))
 		if err != nil {
 			if os.IsNotExist(err) {
 				return fmt.Errorf("source file %s does not exist", source)
@@ -109,13 +132,21 @@ func resourceObsBucketObjectPut(d *schema.ResourceData, meta interface{}) error
 			return err
 		}
 
-		// put source file
-		resp, err = putFileToObject(client, d)
+		if info.Size() >= int64(d.Get("multipart_threshold").(int)) {
+			// upload large file in parts
+			versionID, err = uploadFileToObject(client, d, info.Size())
+		} else {
+			// put source file
+			versionID, err = putFileToObject(client, d)
+		}
+		if err != nil {
+			return GetObsError("error putting object to OBS bucket", d.Get("bucket").(string), err)
+		}
 	}
 
 	if _, ok := d.GetOk("content"); ok {
 		// put content
-		resp, err = putContentToObject(client, d)
+		versionID, err = putContentToObject(client, d)
 	}
 
 	bucket := d.Get("bucket").(string)
@@ -124,9 +155,9 @@ func resourceObsBucketObjectPut(d *schema.ResourceData, meta interface{}) error
 		return GetObsError("error putting object to OBS bucket", bucket, err)
 	}
 
-	log.Printf("[DEBUG] Response of putting %s to OBS Bucket %s: %#v", key, bucket, resp)
-	if resp.VersionId != "null" {
-		err = d.Set("version_id", resp.VersionId)
+	log.Printf("[DEBUG] Version of %s put to OBS Bucket %s: %s", key, bucket, versionID)
+	if versionID != "null" {
+		err = d.Set("version_id", versionID)
 	} else {
 		err = d.Set("version_id", "")
 	}
@@ -139,6 +170,15 @@ func resourceObsBucketObjectPut(d *schema.ResourceData, meta interface{}) error
 	return resourceObsBucketObjectRead(d, meta)
 }
 
+func resourceObsBucketObjectUpdate(d *schema.ResourceData, meta interface{}) error {
+	// `multipart_threshold`, `part_size` and `parallel_parts` only affect the way the object is uploaded
+	if !d.HasChanges("source", "content", "source_hash", "storage_class", "acl",
+		"encryption", "kms_key_id", "content_type", "etag") {
+		return resourceObsBucketObjectRead(d, meta)
+	}
+	return resourceObsBucketObjectPut(d, meta)
+}
+
 func basicInput(d *schema.ResourceData) obs.PutObjectBasicInput {
 	common := obs.PutObjectBasicInput{
 		ObjectOperationInput: obs.ObjectOperationInput{
@@ -165,7 +205,7 @@ func basicInput(d *schema.ResourceData) obs.PutObjectBasicInput {
 	return common
 }
 
-func putContentToObject(obsClient *obs.ObsClient, d *schema.ResourceData) (*obs.PutObjectOutput, error) {
+func putContentToObject(obsClient *obs.ObsClient, d *schema.ResourceData) (string, error) {
 	content := d.Get("content").(string)
 
 	putInput := &obs.PutObjectInput{
@@ -177,17 +217,50 @@ func putContentToObject(obsClient *obs.ObsClient, d *schema.ResourceData) (*obs.
 	body := bytes.NewReader([]byte(content))
 	putInput.Body = body
 
-	return obsClient.PutObject(putInput)
+	resp, err := obsClient.PutObject(putInput)
+	if err != nil {
+		return "", err
+	}
+	return resp.VersionId, nil
 }
 
-func putFileToObject(obsClient *obs.ObsClient, d *schema.ResourceData) (*obs.PutObjectOutput, error) {
+func putFileToObject(obsClient *obs.ObsClient, d *schema.ResourceData) (string, error) {
 	putInput := &obs.PutFileInput{
 		PutObjectBasicInput: basicInput(d),
 	}
 	putInput.SourceFile = d.Get("source").(string)
 
 	log.Printf("[DEBUG] putting %s to OBS Bucket %s, opts: %#v", putInput.Key, putInput.Bucket, putInput)
-	return obsClient.PutFile(putInput)
+	resp, err := obsClient.PutFile(putInput)
+	if err != nil {
+		return "", err
+	}
+	return resp.VersionId, nil
+}
+
+func uploadFileToObject(obsClient *obs.ObsClient, d *schema.ResourceData, size int64) (string, error) {
+	file, err := os.Open(d.Get("source").(string))
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	uploader := &objectUploader{
+		client: obsClient,
+		input:  basicInput(d),
+	}
+	opts := common.MultipartUploadOpts{
+		PartSize:      int64(d.Get("part_size").(int)),
+		ParallelParts: d.Get("parallel_parts").(int),
+		// ETag of KMS encrypted object isn't MD5 of its content
+		VerifyETag: !d.Get("encryption").(bool),
+	}
+	log.Printf("[DEBUG] uploading %s to OBS Bucket %s in parts, opts: %#v", uploader.input.Key, uploader.input.Bucket, opts)
+	result, err := common.MultipartUpload(uploader, file, size, opts)
+	if err != nil {
+		return "", err
+	}
+	return result.VersionID, nil
 }
 
 func resourceObsBucketObjectRead(d *schema.ResourceData, meta interface{}) error {
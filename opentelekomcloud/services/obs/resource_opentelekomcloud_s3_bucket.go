@@ -0,0 +1,826 @@
+package obs
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/obs"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+// ResourceS3Bucket is the compatibility resource for the S3 API users,
+// it manages the bucket using the OBS API keeping the S3 schema
+func ResourceS3Bucket() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceS3BucketCreate,
+		Read:   resourceS3BucketRead,
+		Update: resourceS3BucketUpdate,
+		Delete: resourceS3BucketDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceS3BucketImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"bucket_prefix"},
+			},
+			"bucket_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"bucket_domain_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"arn": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"acl": {
+				Type:     schema.TypeString,
+				Default:  "private",
+				Optional: true,
+			},
+
+			"policy": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     common.ValidateJsonString,
+				DiffSuppressFunc: common.SuppressEquivalentAwsPolicyDiffs,
+			},
+
+			"cors_rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_headers": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"allowed_methods": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"allowed_origins": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"expose_headers": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"max_age_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"website": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"index_document": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"error_document": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"redirect_all_requests_to": {
+							Type: schema.TypeString,
+							ConflictsWith: []string{
+								"website.0.index_document",
+								"website.0.error_document",
+								"website.0.routing_rules",
+							},
+							Optional: true,
+						},
+
+						"routing_rules": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: common.ValidateJsonString,
+							StateFunc: func(v interface{}) string {
+								json, _ := common.NormalizeJsonString(v)
+								return json
+							},
+						},
+					},
+				},
+			},
+
+			"hosted_zone_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"website_endpoint": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"website_domain": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"versioning": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"mfa_delete": {
+							Type:       schema.TypeBool,
+							Optional:   true,
+							Default:    false,
+							Deprecated: "MFA delete is not supported by OBS, the value is ignored",
+						},
+					},
+				},
+			},
+
+			"logging": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"target_bucket": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"target_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+				Set: func(v interface{}) int {
+					var buf bytes.Buffer
+					m := v.(map[string]interface{})
+					buf.WriteString(fmt.Sprintf("%s-", m["target_bucket"]))
+					buf.WriteString(fmt.Sprintf("%s-", m["target_prefix"]))
+					return hashcode.String(buf.String())
+				},
+			},
+
+			"lifecycle_rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validateS3BucketLifecycleRuleId,
+						},
+						"prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"abort_incomplete_multipart_upload_days": {
+							Type:       schema.TypeInt,
+							Optional:   true,
+							Deprecated: "Aborting incomplete multipart uploads is not supported by OBS, the value is ignored",
+						},
+						"expiration": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Set:      ExpirationHash,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"date": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateS3BucketLifecycleTimestamp,
+									},
+									"days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validateS3BucketLifecycleExpirationDays,
+									},
+									"expired_object_delete_marker": {
+										Type:       schema.TypeBool,
+										Optional:   true,
+										Deprecated: "Removing expired object delete markers is not supported by OBS, the value is ignored",
+									},
+								},
+							},
+						},
+						"noncurrent_version_expiration": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Set:      ExpirationHash,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validateS3BucketLifecycleExpirationDays,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"force_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"tags": common.TagsSchema(),
+		},
+	}
+}
+
+func resourceS3BucketCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	region := config.GetRegion(d)
+	client, err := config.NewObjectStorageClient(region)
+	if err != nil {
+		return fmt.Errorf("error creating OBS client: %s", err)
+	}
+
+	// Get the bucket and acl
+	var bucket string
+	if v, ok := d.GetOk("bucket"); ok {
+		bucket = v.(string)
+	} else if v, ok := d.GetOk("bucket_prefix"); ok {
+		bucket = resource.PrefixedUniqueId(v.(string))
+	} else {
+		bucket = resource.UniqueId()
+	}
+	_ = d.Set("bucket", bucket)
+
+	if err := ValidateS3BucketName(bucket, region); err != nil {
+		return fmt.Errorf("error validating S3 bucket name: %s", err)
+	}
+
+	opts := &obs.CreateBucketInput{
+		Bucket: bucket,
+		ACL:    obs.AclType(d.Get("acl").(string)),
+	}
+	opts.Location = region
+	log.Printf("[DEBUG] S3 bucket create opts: %#v", opts)
+
+	err = retryOnObsCodes([]string{"OperationAborted"}, 5*time.Minute, func() error {
+		_, err := client.CreateBucket(opts)
+		return err
+	})
+	if err != nil {
+		return GetObsError("error creating S3 bucket", bucket, err)
+	}
+
+	// Assign the bucket name as the resource ID
+	d.SetId(bucket)
+	return resourceS3BucketUpdate(d, meta)
+}
+
+func resourceS3BucketUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.NewObjectStorageClient(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OBS client: %s", err)
+	}
+
+	if d.HasChange("tags") {
+		if err := resourceS3BucketTagsUpdate(client, d); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("policy") {
+		if err := resourceS3BucketPolicyUpdate(client, d); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("cors_rule") {
+		if err := resourceObsBucketCorsUpdate(client, d); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("website") {
+		if err := resourceObsBucketWebsiteUpdate(client, d); err != nil {
+			return err
+		}
+		if len(d.Get("website").([]interface{})) == 0 {
+			mErr := multierror.Append(nil,
+				d.Set("website_endpoint", ""),
+				d.Set("website_domain", ""),
+			)
+			if err := mErr.ErrorOrNil(); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("versioning") {
+		if err := resourceS3BucketVersioningUpdate(client, d); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("acl") && !d.IsNewResource() {
+		if err := resourceObsBucketAclUpdate(client, d); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("logging") {
+		if err := resourceObsBucketLoggingUpdate(client, d); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("lifecycle_rule") {
+		if err := resourceS3BucketLifecycleUpdate(client, d); err != nil {
+			return err
+		}
+	}
+
+	return resourceS3BucketRead(d, meta)
+}
+
+func resourceS3BucketRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.NewObjectStorageClient(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OBS client: %s", err)
+	}
+
+	_, err = client.HeadBucket(d.Id())
+	if err != nil {
+		if obsError, ok := err.(obs.ObsError); ok && obsError.StatusCode == 404 {
+			log.Printf("[WARN] S3 bucket (%s) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading S3 bucket %s: %s", d.Id(), err)
+	}
+
+	// In the import case, we won't have this
+	if _, ok := d.GetOk("bucket"); !ok {
+		_ = d.Set("bucket", d.Id())
+	}
+	bucket := d.Get("bucket").(string)
+
+	location, err := client.GetBucketLocation(d.Id())
+	if err != nil {
+		return GetObsError("error getting location of S3 bucket", bucket, err)
+	}
+	region := normalizeRegion(location.Location)
+
+	mErr := multierror.Append(nil,
+		d.Set("bucket_domain_name", BucketDomainName(bucket, config.GetRegion(d))),
+		d.Set("region", region),
+	)
+	if _, ok := d.GetOk("website"); ok {
+		endpoint := WebsiteEndpoint(bucket, region)
+		mErr = multierror.Append(mErr,
+			d.Set("website_endpoint", endpoint.Endpoint),
+			d.Set("website_domain", endpoint.Domain),
+		)
+	}
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmt.Errorf("error setting S3 bucket fields: %s", err)
+	}
+
+	// Read the policy
+	if _, ok := d.GetOk("policy"); ok {
+		if err := setS3BucketPolicy(client, d); err != nil {
+			return err
+		}
+	}
+
+	// Read the CORS rules
+	if err := setObsBucketCorsRules(client, d); err != nil {
+		return err
+	}
+
+	// Read the website configuration
+	if err := setObsBucketWebsiteConfiguration(client, d); err != nil {
+		return err
+	}
+
+	// Read the versioning
+	if err := setS3BucketVersioning(client, d); err != nil {
+		return err
+	}
+
+	// Read the logging configuration
+	if err := setObsBucketLogging(client, d); err != nil {
+		return err
+	}
+
+	// Read the lifecycle configuration
+	if err := setS3BucketLifecycleConfiguration(client, d); err != nil {
+		return err
+	}
+
+	// Read the tags
+	return setObsBucketTags(client, d)
+}
+
+func resourceS3BucketDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.NewObjectStorageClient(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OBS client: %s", err)
+	}
+
+	bucket := d.Id()
+	log.Printf("[DEBUG] Deleting S3 bucket: %s", bucket)
+	_, err = client.DeleteBucket(bucket)
+	if err != nil {
+		if obsError, ok := err.(obs.ObsError); ok && obsError.Code == "BucketNotEmpty" && d.Get("force_destroy").(bool) {
+			log.Printf("[DEBUG] S3 bucket %s is not empty, deleting all object versions", bucket)
+			if err := deleteAllBucketObjectVersions(client, bucket); err != nil {
+				return err
+			}
+			// this line recurses until all objects are deleted or an error is returned
+			return resourceS3BucketDelete(d, meta)
+		}
+		return GetObsError("error deleting S3 bucket", bucket, err)
+	}
+	return nil
+}
+
+func resourceS3BucketTagsUpdate(client *obs.ObsClient, d *schema.ResourceData) error {
+	if len(d.Get("tags").(map[string]interface{})) != 0 {
+		return retryOnObsCodes([]string{"NoSuchBucket", "OperationAborted"}, time.Minute, func() error {
+			return resourceObsBucketTagsUpdate(client, d)
+		})
+	}
+
+	bucket := d.Get("bucket").(string)
+	log.Printf("[DEBUG] Removing tags of S3 bucket %s", bucket)
+	err := retryOnObsCodes([]string{"NoSuchBucket", "OperationAborted"}, time.Minute, func() error {
+		_, err := client.DeleteBucketTagging(bucket)
+		return err
+	})
+	if err != nil {
+		return GetObsError("error deleting tags of S3 bucket", bucket, err)
+	}
+	return nil
+}
+
+func resourceS3BucketPolicyUpdate(client *obs.ObsClient, d *schema.ResourceData) error {
+	bucket := d.Get("bucket").(string)
+	policy := d.Get("policy").(string)
+
+	if policy == "" {
+		log.Printf("[DEBUG] S3 bucket: %s, delete policy", bucket)
+		err := retryOnObsCodes([]string{"NoSuchBucket"}, time.Minute, func() error {
+			_, err := client.DeleteBucketPolicy(bucket)
+			return err
+		})
+		if err != nil {
+			return GetObsError("error deleting policy of S3 bucket", bucket, err)
+		}
+		return nil
+	}
+
+	log.Printf("[DEBUG] S3 bucket: %s, put policy: %s", bucket, policy)
+	opts := &obs.SetBucketPolicyInput{
+		Bucket: bucket,
+		Policy: policy,
+	}
+	err := retryOnObsCodes([]string{"MalformedPolicy", "NoSuchBucket"}, time.Minute, func() error {
+		_, err := client.SetBucketPolicy(opts)
+		return err
+	})
+	if err != nil {
+		return GetObsError("error putting policy of S3 bucket", bucket, err)
+	}
+	return nil
+}
+
+func resourceS3BucketVersioningUpdate(client *obs.ObsClient, d *schema.ResourceData) error {
+	bucket := d.Get("bucket").(string)
+
+	input := &obs.SetBucketVersioningInput{}
+	input.Bucket = bucket
+	input.Status = obs.VersioningStatusSuspended
+	if v := d.Get("versioning").([]interface{}); len(v) > 0 && v[0] != nil {
+		versioning := v[0].(map[string]interface{})
+		if versioning["enabled"].(bool) {
+			input.Status = obs.VersioningStatusEnabled
+		}
+		if versioning["mfa_delete"].(bool) {
+			log.Printf("[WARN] MFA delete is not supported by OBS, ignoring it for S3 bucket %s", bucket)
+		}
+	}
+	log.Printf("[DEBUG] S3 put bucket versioning: %#v", input)
+
+	_, err := client.SetBucketVersioning(input)
+	if err != nil {
+		return GetObsError("error putting versioning of S3 bucket", bucket, err)
+	}
+	return nil
+}
+
+func expandS3LifecycleRule(src map[string]interface{}) (obs.LifecycleRule, error) {
+	rule := obs.LifecycleRule{
+		// OTC only supports deprecated location for this.
+		Prefix: src["prefix"].(string),
+		Status: obs.RuleStatusDisabled,
+	}
+
+	if val, ok := src["id"].(string); ok && val != "" {
+		rule.ID = val
+	} else {
+		rule.ID = resource.PrefixedUniqueId("tf-s3-lifecycle-")
+	}
+
+	if val, ok := src["enabled"].(bool); ok && val {
+		rule.Status = obs.RuleStatusEnabled
+	}
+
+	if val, ok := src["abort_incomplete_multipart_upload_days"].(int); ok && val > 0 {
+		log.Printf("[WARN] Aborting incomplete multipart uploads is not supported by OBS, ignoring it for rule %s", rule.ID)
+	}
+
+	expiration := src["expiration"].(*schema.Set).List()
+	if len(expiration) > 0 {
+		e := expiration[0].(map[string]interface{})
+		if val, ok := e["date"].(string); ok && val != "" {
+			t, err := time.Parse(time.RFC3339, fmt.Sprintf("%sT00:00:00Z", val))
+			if err != nil {
+				return rule, fmt.Errorf("error parsing S3 bucket lifecycle expiration date: %s", err)
+			}
+			rule.Expiration.Date = t
+		} else if val, ok := e["days"].(int); ok && val > 0 {
+			rule.Expiration.Days = val
+		}
+	}
+
+	ncExpiration := src["noncurrent_version_expiration"].(*schema.Set).List()
+	if len(ncExpiration) > 0 {
+		e := ncExpiration[0].(map[string]interface{})
+		if val, ok := e["days"].(int); ok && val > 0 {
+			rule.NoncurrentVersionExpiration.NoncurrentDays = val
+		}
+	}
+	return rule, nil
+}
+
+func resourceS3BucketLifecycleUpdate(client *obs.ObsClient, d *schema.ResourceData) error {
+	bucket := d.Get("bucket").(string)
+	lifecycleRules := d.Get("lifecycle_rule").([]interface{})
+
+	if len(lifecycleRules) == 0 {
+		log.Printf("[DEBUG] Removing lifecycle rules of S3 bucket %s", bucket)
+		_, err := client.DeleteBucketLifecycleConfiguration(bucket)
+		if err != nil {
+			return GetObsError("error removing lifecycle rules of S3 bucket", bucket, err)
+		}
+		return nil
+	}
+
+	rules := make([]obs.LifecycleRule, len(lifecycleRules))
+	for i, lifecycleRule := range lifecycleRules {
+		rule, err := expandS3LifecycleRule(lifecycleRule.(map[string]interface{}))
+		if err != nil {
+			return err
+		}
+		rules[i] = rule
+	}
+
+	opts := &obs.SetBucketLifecycleConfigurationInput{}
+	opts.Bucket = bucket
+	opts.LifecycleRules = rules
+	log.Printf("[DEBUG] S3 put bucket lifecycle: %#v", opts)
+
+	_, err := client.SetBucketLifecycleConfiguration(opts)
+	if err != nil {
+		return GetObsError("error putting lifecycle rules of S3 bucket", bucket, err)
+	}
+	return nil
+}
+
+func setS3BucketPolicy(client *obs.ObsClient, d *schema.ResourceData) error {
+	policy := ""
+	output, err := client.GetBucketPolicy(d.Id())
+	if err == nil && output.Policy != "" {
+		policy, err = common.NormalizeJsonString(output.Policy)
+		if err != nil {
+			return fmt.Errorf("policy contains an invalid JSON: %s", err)
+		}
+	}
+	return d.Set("policy", policy)
+}
+
+func setS3BucketVersioning(client *obs.ObsClient, d *schema.ResourceData) error {
+	bucket := d.Id()
+	output, err := client.GetBucketVersioning(bucket)
+	if err != nil {
+		return GetObsError("error getting versioning status of S3 bucket", bucket, err)
+	}
+
+	versioning := []map[string]interface{}{
+		{
+			"enabled": output.Status == obs.VersioningStatusEnabled,
+			// MFA delete isn't supported by OBS, the configured value is kept
+			"mfa_delete": d.Get("versioning.0.mfa_delete").(bool),
+		},
+	}
+	if err := d.Set("versioning", versioning); err != nil {
+		return fmt.Errorf("error saving versioning of S3 bucket %s: %s", bucket, err)
+	}
+	return nil
+}
+
+func setS3BucketLifecycleConfiguration(client *obs.ObsClient, d *schema.ResourceData) error {
+	bucket := d.Id()
+	output, err := client.GetBucketLifecycleConfiguration(bucket)
+	if err != nil {
+		if obsError, ok := err.(obs.ObsError); ok && obsError.Code == "NoSuchLifecycleConfiguration" {
+			return d.Set("lifecycle_rule", nil)
+		}
+		return GetObsError("error getting lifecycle configuration of S3 bucket", bucket, err)
+	}
+
+	rules := make([]map[string]interface{}, len(output.LifecycleRules))
+	for i, lifecycleRule := range output.LifecycleRules {
+		prefix := fmt.Sprintf("lifecycle_rule.%d.", i)
+		rule := map[string]interface{}{
+			"id":      lifecycleRule.ID,
+			"prefix":  lifecycleRule.Prefix,
+			"enabled": lifecycleRule.Status == obs.RuleStatusEnabled,
+			// not supported by OBS, the configured value is kept
+			"abort_incomplete_multipart_upload_days": d.Get(prefix + "abort_incomplete_multipart_upload_days").(int),
+		}
+
+		expiration := make(map[string]interface{})
+		if !lifecycleRule.Expiration.Date.IsZero() {
+			expiration["date"] = lifecycleRule.Expiration.Date.Format("2006-01-02")
+		}
+		if days := lifecycleRule.Expiration.Days; days > 0 {
+			expiration["days"] = days
+		}
+		if old := d.Get(prefix + "expiration").(*schema.Set).List(); len(old) > 0 {
+			// not supported by OBS, the configured value is kept
+			expiration["expired_object_delete_marker"] = old[0].(map[string]interface{})["expired_object_delete_marker"]
+		}
+		if len(expiration) > 0 {
+			rule["expiration"] = schema.NewSet(ExpirationHash, []interface{}{expiration})
+		}
+
+		if days := lifecycleRule.NoncurrentVersionExpiration.NoncurrentDays; days > 0 {
+			expiration := map[string]interface{}{
+				"days": days,
+			}
+			rule["noncurrent_version_expiration"] = schema.NewSet(ExpirationHash, []interface{}{expiration})
+		}
+		rules[i] = rule
+	}
+
+	if err := d.Set("lifecycle_rule", rules); err != nil {
+		return fmt.Errorf("error saving lifecycle configuration of S3 bucket %s: %s", bucket, err)
+	}
+	return nil
+}
+
+// deleteAllBucketObjectVersions deletes all versions and delete markers of the bucket objects
+func deleteAllBucketObjectVersions(client *obs.ObsClient, bucket string) error {
+	output, err := client.ListVersions(&obs.ListVersionsInput{Bucket: bucket})
+	if err != nil {
+		return GetObsError("error listing object versions of S3 bucket", bucket, err)
+	}
+
+	var objects []obs.ObjectToDelete
+	for _, marker := range output.DeleteMarkers {
+		objects = append(objects, obs.ObjectToDelete{Key: marker.Key, VersionId: marker.VersionId})
+	}
+	for _, version := range output.Versions {
+		objects = append(objects, obs.ObjectToDelete{Key: version.Key, VersionId: version.VersionId})
+	}
+	if len(objects) == 0 {
+		return nil
+	}
+
+	deleteOutput, err := client.DeleteObjects(&obs.DeleteObjectsInput{
+		Bucket:  bucket,
+		Objects: objects,
+	})
+	if err != nil {
+		return GetObsError("error deleting object versions of S3 bucket", bucket, err)
+	}
+	if len(deleteOutput.Errors) > 0 {
+		return fmt.Errorf("error deleting object versions of S3 bucket %s: %#v", bucket, deleteOutput.Errors)
+	}
+	return nil
+}
+
+func WebsiteEndpoint(bucket string, region string) *S3Website {
+	domain := WebsiteDomainUrl(region)
+	return &S3Website{Endpoint: fmt.Sprintf("%s.%s", bucket, domain), Domain: domain}
+}
+
+func WebsiteDomainUrl(region string) string {
+	region = normalizeRegion(region)
+
+	return fmt.Sprintf("s3-website.%s.amazonaws.com", region)
+}
+
+func normalizeRegion(region string) string {
+	// Default to us-east-1 if the bucket doesn't have a region:
+	// http://docs.aws.amazon.com/AmazonS3/latest/API/RESTBucketGETlocation.html
+	if region == "" {
+		region = "us-east-1"
+	}
+
+	return region
+}
+
+// ValidateS3BucketName validates any S3 bucket name that is not inside the us-east-1 region.
+// Buckets outside of this region have to be DNS-compliant. After the same restrictions are
+// applied to buckets in the us-east-1 region, this function can be refactored as a SchemaValidateFunc
+func ValidateS3BucketName(value string, region string) error {
+	if region != "us-east-1" {
+		if (len(value) < 3) || (len(value) > 63) {
+			return fmt.Errorf("%q must contain from 3 to 63 characters", value)
+		}
+		if !regexp.MustCompile(`^[0-9a-z-.]+$`).MatchString(value) {
+			return fmt.Errorf("only lowercase alphanumeric characters and hyphens allowed in %q", value)
+		}
+		if regexp.MustCompile(`^(?:[0-9]{1,3}\.){3}[0-9]{1,3}$`).MatchString(value) {
+			return fmt.Errorf("%q must not be formatted as an IP address", value)
+		}
+		if strings.HasPrefix(value, `.`) {
+			return fmt.Errorf("%q cannot start with a period", value)
+		}
+		if strings.HasSuffix(value, `.`) {
+			return fmt.Errorf("%q cannot end with a period", value)
+		}
+		if strings.Contains(value, `..`) {
+			return fmt.Errorf("%q can be only one period between labels", value)
+		}
+	} else {
+		if len(value) > 255 {
+			return fmt.Errorf("%q must contain less than 256 characters", value)
+		}
+		if !regexp.MustCompile(`^[0-9a-zA-Z-._]+$`).MatchString(value) {
+			return fmt.Errorf("only alphanumeric characters, hyphens, periods, and underscores allowed in %q", value)
+		}
+	}
+	return nil
+}
+
+type S3Website struct {
+	Endpoint, Domain string
+}
@@ -0,0 +1,445 @@
+package obs
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/mitchellh/go-homedir"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/obs"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const (
+	s3ServerSideEncryptionAes256 = "AES256"
+	s3ServerSideEncryptionAwsKms = "aws:kms"
+)
+
+func ResourceS3BucketObject() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceS3BucketObjectPut,
+		Read:   resourceS3BucketObjectRead,
+		Update: resourceS3BucketObjectUpdate,
+		Delete: resourceS3BucketObjectDelete,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"acl": {
+				Type:         schema.TypeString,
+				Default:      "private",
+				Optional:     true,
+				ValidateFunc: ValidateS3BucketObjectAclType,
+			},
+
+			"cache_control": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"content_disposition": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"content_encoding": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"content_language": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"content_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"source": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"content"},
+			},
+
+			"content": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"source"},
+			},
+
+			"source_hash": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"multipart_threshold": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      common.DefaultMultipartThreshold,
+				ValidateFunc: validation.IntAtLeast(common.MinPartSize),
+			},
+
+			"part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      common.DefaultPartSize,
+				ValidateFunc: validation.IntAtLeast(common.MinPartSize),
+			},
+
+			"parallel_parts": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      common.DefaultParallelParts,
+				ValidateFunc: validation.IntBetween(1, 32),
+			},
+
+			"server_side_encryption": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateS3BucketObjectServerSideEncryption,
+				Computed:     true,
+			},
+
+			"sse_kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"etag": {
+				Type: schema.TypeString,
+				// This will conflict with SSE-C and SSE-KMS encryption and multi-part upload,
+				// the Etag then won't match raw-file MD5, `source_hash` should be used instead.
+				// See http://docs.aws.amazon.com/AmazonS3/latest/API/RESTCommonResponseHeaders.html
+				Optional: true,
+				Computed: true,
+				// ConflictsWith: []string{"kms_key_id", "server_side_encryption"},
+			},
+
+			"version_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"website_redirect": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceS3BucketObjectUpdate(d *schema.ResourceData, meta interface{}) error {
+	// `multipart_threshold`, `part_size` and `parallel_parts` only affect the way the object is uploaded
+	if !d.HasChanges("acl", "cache_control", "content_disposition", "content_encoding", "content_language",
+		"content_type", "source", "content", "source_hash", "server_side_encryption", "sse_kms_key_id",
+		"etag", "website_redirect") {
+		return resourceS3BucketObjectRead(d, meta)
+	}
+	return resourceS3BucketObjectPut(d, meta)
+}
+
+func s3ObjectInput(d *schema.ResourceData) obs.PutObjectBasicInput {
+	input := obs.PutObjectBasicInput{
+		ObjectOperationInput: obs.ObjectOperationInput{
+			Bucket:                  d.Get("bucket").(string),
+			Key:                     d.Get("key").(string),
+			ACL:                     obs.AclType(d.Get("acl").(string)),
+			WebsiteRedirectLocation: d.Get("website_redirect").(string),
+		},
+		ContentType: d.Get("content_type").(string),
+	}
+	if v, ok := d.GetOk("server_side_encryption"); ok {
+		input.SseHeader = obs.SseKmsHeader{
+			Encryption: v.(string),
+			Key:        d.Get("sse_kms_key_id").(string),
+		}
+	}
+	return input
+}
+
+func resourceS3BucketObjectPut(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.NewObjectStorageClient(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OBS client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+	input := s3ObjectInput(d)
+
+	var output *obs.PutObjectOutput
+	if v, ok := d.GetOk("source"); ok {
+		source := v.(string)
+		path, err := homedir.Expand(source)
+		if err != nil {
+			return fmt.Errorf("error expanding homedir in source (%s): %s", source, err)
+		}
+		info, err := os.Stat(path)
+		if err != nil {
+			return fmt.Errorf("error reading S3 bucket object source (%s): %s", source, err)
+		}
+
+		if info.Size() >= int64(d.Get("multipart_threshold").(int)) {
+			result, err := uploadFileToS3Object(client, d, input, path, info.Size())
+			if err != nil {
+				return fmt.Errorf("error uploading object to S3 bucket (%s): %s", bucket, err)
+			}
+			output = &obs.PutObjectOutput{ETag: result.ETag, VersionId: result.VersionID}
+		} else {
+			output, err = client.PutFile(&obs.PutFileInput{
+				PutObjectBasicInput: input,
+				SourceFile:          path,
+			})
+			if err != nil {
+				return GetObsError("error putting object in S3 bucket", bucket, err)
+			}
+		}
+	} else if v, ok := d.GetOk("content"); ok {
+		output, err = client.PutObject(&obs.PutObjectInput{
+			PutObjectBasicInput: input,
+			Body:                bytes.NewReader([]byte(v.(string))),
+		})
+		if err != nil {
+			return GetObsError("error putting object in S3 bucket", bucket, err)
+		}
+	} else {
+		return fmt.Errorf("must specify \"source\" or \"content\" field")
+	}
+
+	if err := setS3ObjectContentHeaders(config, d, output.VersionId); err != nil {
+		return err
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("etag", strings.Trim(output.ETag, `"`)),
+		d.Set("version_id", output.VersionId),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return err
+	}
+
+	d.SetId(key)
+	return resourceS3BucketObjectRead(d, meta)
+}
+
+func uploadFileToS3Object(client *obs.ObsClient, d *schema.ResourceData, input obs.PutObjectBasicInput, path string, size int64) (*common.UploadResult, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	uploader := &objectUploader{
+		client: client,
+		input:  input,
+	}
+	opts := common.MultipartUploadOpts{
+		PartSize:      int64(d.Get("part_size").(int)),
+		ParallelParts: d.Get("parallel_parts").(int),
+		// ETag of KMS encrypted object isn't MD5 of its content
+		VerifyETag: d.Get("server_side_encryption").(string) != s3ServerSideEncryptionAwsKms,
+	}
+	log.Printf("[DEBUG] Uploading object to S3 bucket (%s) in parts, opts: %#v", input.Bucket, opts)
+	return common.MultipartUpload(uploader, file, size, opts)
+}
+
+// setS3ObjectContentHeaders sets the content headers which can't be set on the object upload by the OBS SDK
+func setS3ObjectContentHeaders(config *cfg.Config, d *schema.ResourceData, versionID string) error {
+	input := &obs.SetObjectMetadataInput{
+		Bucket:             d.Get("bucket").(string),
+		Key:                d.Get("key").(string),
+		VersionId:          versionID,
+		MetadataDirective:  obs.ReplaceNew,
+		CacheControl:       d.Get("cache_control").(string),
+		ContentDisposition: d.Get("content_disposition").(string),
+		ContentEncoding:    d.Get("content_encoding").(string),
+		ContentLanguage:    d.Get("content_language").(string),
+	}
+	if input.CacheControl == "" && input.ContentDisposition == "" && input.ContentEncoding == "" && input.ContentLanguage == "" {
+		return nil
+	}
+
+	// `metadata` sub-resource is signed by V4 signature only
+	client, err := bucketConfigurationClient(config, config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OBS client: %s", err)
+	}
+	log.Printf("[DEBUG] Setting S3 object headers: %#v", input)
+	if _, err := client.SetObjectMetadata(input); err != nil {
+		return GetObsError("error setting headers of object in S3 bucket", input.Bucket, err)
+	}
+	return nil
+}
+
+// responseHeader returns the first value of the response header
+func responseHeader(headers map[string][]string, name string) string {
+	if values := headers[name]; len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+func resourceS3BucketObjectRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.NewObjectStorageClient(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OBS client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+
+	resp, err := client.GetObjectMetadata(&obs.GetObjectMetadataInput{
+		Bucket: bucket,
+		Key:    key,
+	})
+	if err != nil {
+		// If OBS returns a 404 Request Failure, mark the object as destroyed
+		if obsError, ok := err.(obs.ObsError); ok && obsError.StatusCode == 404 {
+			d.SetId("")
+			log.Printf("[WARN] Error Reading Object (%s), object not found (HTTP status 404)", key)
+			return nil
+		}
+		return GetObsError("error reading object of S3 bucket", bucket, err)
+	}
+	log.Printf("[DEBUG] Reading S3 Bucket Object meta: %#v", resp)
+
+	var encryption, kmsKeyID string
+	if sseHeader, ok := resp.SseHeader.(obs.SseKmsHeader); ok {
+		encryption = sseHeader.Encryption
+		kmsKeyID = sseHeader.Key
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("cache_control", responseHeader(resp.ResponseHeaders, "cache-control")),
+		d.Set("content_disposition", responseHeader(resp.ResponseHeaders, "content-disposition")),
+		d.Set("content_encoding", responseHeader(resp.ResponseHeaders, "content-encoding")),
+		d.Set("content_language", responseHeader(resp.ResponseHeaders, "content-language")),
+		d.Set("content_type", resp.ContentType),
+		d.Set("version_id", resp.VersionId),
+		d.Set("server_side_encryption", encryption),
+		d.Set("website_redirect", resp.WebsiteRedirectLocation),
+		d.Set("sse_kms_key_id", kmsKeyID),
+		d.Set("etag", strings.Trim(resp.ETag, `"`)),
+	)
+	return mErr.ErrorOrNil()
+}
+
+func resourceS3BucketObjectDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.NewObjectStorageClient(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OBS client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+	input := &obs.DeleteObjectInput{
+		Bucket: bucket,
+		Key:    key,
+	}
+
+	if _, ok := d.GetOk("version_id"); ok {
+		// Bucket is versioned, we need to delete all versions
+		vInput := &obs.ListVersionsInput{
+			Bucket: bucket,
+		}
+		vInput.Prefix = key
+		out, err := client.ListVersions(vInput)
+		if err != nil {
+			return GetObsError("failed listing object versions of S3 bucket", bucket, err)
+		}
+
+		for _, v := range out.Versions {
+			if v.Key != key {
+				continue
+			}
+			input.VersionId = v.VersionId
+			if _, err := client.DeleteObject(input); err != nil {
+				return fmt.Errorf("error deleting S3 object version of %s:\n %s:\n %s", key, v.VersionId, err)
+			}
+		}
+		return nil
+	}
+
+	// Just delete the object
+	if _, err := client.DeleteObject(input); err != nil {
+		return fmt.Errorf("error deleting S3 bucket object: %s  Bucket: %q Object: %q", err, bucket, key)
+	}
+	return nil
+}
+
+func ValidateS3BucketObjectAclType(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	cannedAcls := map[string]bool{
+		"private":                   true,
+		"public-read":               true,
+		"public-read-write":         true,
+		"authenticated-read":        true,
+		"aws-exec-read":             true,
+		"bucket-owner-read":         true,
+		"bucket-owner-full-control": true,
+	}
+
+	sentenceJoin := func(m map[string]bool) string {
+		keys := make([]string, 0, len(m))
+		for k := range m {
+			keys = append(keys, fmt.Sprintf("%q", k))
+		}
+		sort.Strings(keys)
+
+		length := len(keys)
+		words := make([]string, length)
+		copy(words, keys)
+
+		words[length-1] = fmt.Sprintf("or %s", words[length-1])
+		return strings.Join(words, ", ")
+	}
+
+	if _, ok := cannedAcls[value]; !ok {
+		errors = append(errors, fmt.Errorf(
+			"%q contains an invalid canned ACL type %q. Valid types are either %s",
+			k, value, sentenceJoin(cannedAcls)))
+	}
+	return
+}
+
+func validateS3BucketObjectServerSideEncryption(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	serverSideEncryption := map[string]bool{
+		s3ServerSideEncryptionAes256: true,
+		s3ServerSideEncryptionAwsKms: true,
+	}
+
+	if _, ok := serverSideEncryption[value]; !ok {
+		errors = append(errors, fmt.Errorf(
+			"%q contains an invalid Server Side Encryption value %q. Valid values are %q and %q",
+			k, value, s3ServerSideEncryptionAes256, s3ServerSideEncryptionAwsKms))
+	}
+	return
+}
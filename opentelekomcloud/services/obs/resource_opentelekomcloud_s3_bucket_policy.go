@@ -1,15 +1,12 @@
-package s3
+package obs
 
 import (
 	"fmt"
 	"log"
 	"time"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/awserr"
-	"github.com/aws/aws-sdk-go/service/s3"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/obs"
 
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
@@ -41,9 +38,9 @@ func ResourceS3BucketPolicy() *schema.Resource {
 
 func resourceS3BucketPolicyPut(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*cfg.Config)
-	s3conn, err := config.S3Client(config.GetRegion(d))
+	client, err := config.NewObjectStorageClient(config.GetRegion(d))
 	if err != nil {
-		return fmt.Errorf("Error creating OpenTelekomCloud s3 client: %s", err)
+		return fmt.Errorf("error creating OBS client: %s", err)
 	}
 
 	bucket := d.Get("bucket").(string)
@@ -53,25 +50,16 @@ func resourceS3BucketPolicyPut(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] S3 bucket: %s, put policy: %s", bucket, policy)
 
-	params := &s3.PutBucketPolicyInput{
-		Bucket: aws.String(bucket),
-		Policy: aws.String(policy),
+	params := &obs.SetBucketPolicyInput{
+		Bucket: bucket,
+		Policy: policy,
 	}
-
-	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
-		if _, err := s3conn.PutBucketPolicy(params); err != nil {
-			if awserr, ok := err.(awserr.Error); ok {
-				if awserr.Code() == "MalformedPolicy" {
-					return resource.RetryableError(awserr)
-				}
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
+	err = retryOnObsCodes([]string{"MalformedPolicy"}, time.Minute, func() error {
+		_, err := client.SetBucketPolicy(params)
+		return err
 	})
-
 	if err != nil {
-		return fmt.Errorf("Error putting S3 policy: %s", err)
+		return GetObsError("error putting policy of S3 bucket", bucket, err)
 	}
 
 	return nil
@@ -79,19 +67,17 @@ func resourceS3BucketPolicyPut(d *schema.ResourceData, meta interface{}) error {
 
 func resourceS3BucketPolicyRead(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*cfg.Config)
-	s3conn, err := config.S3Client(config.GetRegion(d))
+	client, err := config.NewObjectStorageClient(config.GetRegion(d))
 	if err != nil {
-		return fmt.Errorf("Error creating OpenTelekomCloud s3 client: %s", err)
+		return fmt.Errorf("error creating OBS client: %s", err)
 	}
 
 	log.Printf("[DEBUG] S3 bucket policy, read for bucket: %s", d.Id())
-	pol, err := s3conn.GetBucketPolicy(&s3.GetBucketPolicyInput{
-		Bucket: aws.String(d.Id()),
-	})
+	pol, err := client.GetBucketPolicy(d.Id())
 
 	v := ""
-	if err == nil && pol.Policy != nil {
-		v = *pol.Policy
+	if err == nil {
+		v = pol.Policy
 	}
 	if err := d.Set("policy", v); err != nil {
 		return err
@@ -102,23 +88,20 @@ func resourceS3BucketPolicyRead(d *schema.ResourceData, meta interface{}) error
 
 func resourceS3BucketPolicyDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*cfg.Config)
-	s3conn, err := config.S3Client(config.GetRegion(d))
+	client, err := config.NewObjectStorageClient(config.GetRegion(d))
 	if err != nil {
-		return fmt.Errorf("Error creating OpenTelekomCloud s3 client: %s", err)
+		return fmt.Errorf("error creating OBS client: %s", err)
 	}
 
 	bucket := d.Get("bucket").(string)
 
 	log.Printf("[DEBUG] S3 bucket: %s, delete policy", bucket)
-	_, err = s3conn.DeleteBucketPolicy(&s3.DeleteBucketPolicyInput{
-		Bucket: aws.String(bucket),
-	})
-
+	_, err = client.DeleteBucketPolicy(bucket)
 	if err != nil {
-		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "NoSuchBucket" {
+		if obsError, ok := err.(obs.ObsError); ok && obsError.Code == "NoSuchBucket" {
 			return nil
 		}
-		return fmt.Errorf("Error deleting S3 policy: %s", err)
+		return GetObsError("error deleting policy of S3 bucket", bucket, err)
 	}
 
 	return nil
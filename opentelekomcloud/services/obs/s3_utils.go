@@ -1,4 +1,4 @@
-package s3
+package obs
 
 import (
 	"bytes"
@@ -7,14 +7,26 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/obs"
 )
 
-func pointersMapToStringList(pointers map[string]*string) map[string]interface{} {
-	list := make(map[string]interface{}, len(pointers))
-	for i, v := range pointers {
-		list[i] = *v
-	}
-	return list
+// retryOnObsCodes retries `f` while it fails with one of the given OBS error codes
+func retryOnObsCodes(codes []string, timeout time.Duration, f func() error) error {
+	return resource.Retry(timeout, func() *resource.RetryError {
+		err := f()
+		if err == nil {
+			return nil
+		}
+		if obsError, ok := err.(obs.ObsError); ok {
+			for _, code := range codes {
+				if obsError.Code == code {
+					return resource.RetryableError(err)
+				}
+			}
+		}
+		return resource.NonRetryableError(err)
+	})
 }
 
 func ExpirationHash(v interface{}) int {
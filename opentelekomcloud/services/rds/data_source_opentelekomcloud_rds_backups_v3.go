@@ -0,0 +1,144 @@
+package rds
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func DataSourceRdsBackupsV3() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceRdsBackupsV3Read,
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"backup_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"auto", "manual", "fragment", "incremental",
+				}, false),
+			},
+			"date": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"backups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"begin_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"restore_time": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start_time": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"end_time": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRdsBackupsV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.RdsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating RDSv3 client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	backupList, err := listBackups(client, ListBackupsOpts{
+		InstanceID: instanceID,
+		BackupType: d.Get("backup_type").(string),
+	})
+	if err != nil {
+		return fmt.Errorf("error listing RDSv3 backups: %s", err)
+	}
+
+	backups := make([]map[string]interface{}, len(backupList))
+	for i, backup := range backupList {
+		backups[i] = map[string]interface{}{
+			"id":          backup.ID,
+			"name":        backup.Name,
+			"description": backup.Description,
+			"type":        backup.Type,
+			"size":        backup.Size,
+			"status":      backup.Status,
+			"begin_time":  backup.BeginTime,
+			"end_time":    backup.EndTime,
+		}
+	}
+	if err := d.Set("backups", backups); err != nil {
+		return fmt.Errorf("error setting backup list: %s", err)
+	}
+
+	restoreTimes, err := listRestoreTimes(client, instanceID, d.Get("date").(string))
+	if err != nil {
+		return fmt.Errorf("error listing RDSv3 restore time: %s", err)
+	}
+	restoreTimeList := make([]map[string]interface{}, len(restoreTimes))
+	for i, restoreTime := range restoreTimes {
+		restoreTimeList[i] = map[string]interface{}{
+			"start_time": int(restoreTime.StartTime),
+			"end_time":   int(restoreTime.EndTime),
+		}
+	}
+	if err := d.Set("restore_time", restoreTimeList); err != nil {
+		return fmt.Errorf("error setting restore time list: %s", err)
+	}
+
+	// filters are part of the ID, so data sources with different filters don't share the ID
+	d.SetId(hashcode.Strings([]string{instanceID, d.Get("backup_type").(string), d.Get("date").(string)}))
+
+	return nil
+}
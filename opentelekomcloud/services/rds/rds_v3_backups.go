@@ -0,0 +1,160 @@
+package rds
+
+import (
+	"fmt"
+	"net/url"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/rds/v3/instances"
+)
+
+// BackupDatabase represents a single database included into the backup (Microsoft SQL Server only).
+type BackupDatabase struct {
+	Name string `json:"name"`
+}
+
+// CreateBackupOpts contains all the values needed to create a manual backup.
+type CreateBackupOpts struct {
+	InstanceID  string           `json:"instance_id" required:"true"`
+	Name        string           `json:"name" required:"true"`
+	Description string           `json:"description,omitempty"`
+	Databases   []BackupDatabase `json:"databases,omitempty"`
+}
+
+// ListBackupsOpts contains query parameters for listing backups.
+type ListBackupsOpts struct {
+	InstanceID string `q:"instance_id"`
+	BackupID   string `q:"backup_id"`
+	BackupType string `q:"backup_type"`
+	BeginTime  string `q:"begin_time"`
+	EndTime    string `q:"end_time"`
+}
+
+// Backup represents RDSv3 backup.
+type Backup struct {
+	ID          string              `json:"id"`
+	InstanceID  string              `json:"instance_id"`
+	Name        string              `json:"name"`
+	Description string              `json:"description"`
+	Type        string              `json:"type"`
+	Size        float64             `json:"size"`
+	Status      string              `json:"status"`
+	BeginTime   string              `json:"begin_time"`
+	EndTime     string              `json:"end_time"`
+	Datastore   instances.Datastore `json:"datastore"`
+	Databases   []BackupDatabase    `json:"databases"`
+}
+
+// RestoreTime represents restorable time window of the instance. Values are UNIX timestamps in milliseconds.
+type RestoreTime struct {
+	StartTime int64 `json:"start_time"`
+	EndTime   int64 `json:"end_time"`
+}
+
+// RestorePoint describes data source for the instance being created from backup.
+type RestorePoint struct {
+	InstanceID  string `json:"instance_id" required:"true"`
+	Type        string `json:"type" required:"true"`
+	BackupID    string `json:"backup_id,omitempty"`
+	RestoreTime int64  `json:"restore_time,omitempty"`
+}
+
+// CreateRdsOpts extends instances.CreateRdsOpts with the restore point.
+type CreateRdsOpts struct {
+	instances.CreateRdsOpts
+	RestorePoint *RestorePoint
+}
+
+// ToInstancesCreateMap casts a CreateRdsOpts struct to a map.
+// It overrides instances.ToInstancesCreateMap to add the `restore_point` field.
+func (opts CreateRdsOpts) ToInstancesCreateMap() (map[string]interface{}, error) {
+	b, err := opts.CreateRdsOpts.ToInstancesCreateMap()
+	if err != nil {
+		return nil, err
+	}
+	if opts.RestorePoint != nil {
+		restorePoint, err := golangsdk.BuildRequestBody(opts.RestorePoint, "")
+		if err != nil {
+			return nil, err
+		}
+		b["restore_point"] = restorePoint
+	}
+	return b, nil
+}
+
+func createBackup(client *golangsdk.ServiceClient, opts CreateBackupOpts) (*Backup, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+	var res struct {
+		Backup Backup `json:"backup"`
+	}
+	_, err = client.Post(client.ServiceURL("backups"), b, &res, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &res.Backup, nil
+}
+
+func listBackups(client *golangsdk.ServiceClient, opts ListBackupsOpts) ([]Backup, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return nil, err
+	}
+	var res struct {
+		Backups []Backup `json:"backups"`
+	}
+	_, err = client.Get(client.ServiceURL("backups")+q.String(), &res, &golangsdk.RequestOpts{
+		MoreHeaders: map[string]string{"Content-Type": "application/json"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Backups, nil
+}
+
+// GetRdsBackup returns the backup by its ID, `nil` is returned if the backup doesn't exist
+func GetRdsBackup(client *golangsdk.ServiceClient, instanceID, backupID string) (*Backup, error) {
+	backupList, err := listBackups(client, ListBackupsOpts{
+		InstanceID: instanceID,
+		BackupID:   backupID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, backup := range backupList {
+		if backup.ID == backupID {
+			return &backup, nil
+		}
+	}
+	return nil, nil
+}
+
+func deleteBackup(client *golangsdk.ServiceClient, backupID string) error {
+	_, err := client.Delete(client.ServiceURL("backups", backupID), &golangsdk.RequestOpts{
+		OkCodes:     []int{200, 202, 204},
+		MoreHeaders: map[string]string{"Content-Type": "application/json"},
+	})
+	return err
+}
+
+// listRestoreTimes returns restorable time windows of the instance, `date` has `yyyy-mm-dd` format
+func listRestoreTimes(client *golangsdk.ServiceClient, instanceID, date string) ([]RestoreTime, error) {
+	u := client.ServiceURL("instances", instanceID, "restore-time")
+	if date != "" {
+		u = fmt.Sprintf("%s?date=%s", u, url.QueryEscape(date))
+	}
+	var res struct {
+		RestoreTime []RestoreTime `json:"restore_time"`
+	}
+	_, err := client.Get(u, &res, &golangsdk.RequestOpts{
+		MoreHeaders: map[string]string{"Content-Type": "application/json"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.RestoreTime, nil
+}
@@ -0,0 +1,237 @@
+package rds
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/mutexkv"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/rds/v3/instances"
+)
+
+// rdsMutexKV is used to serialize database management operations on the same instance,
+// RDS doesn't allow running them concurrently.
+var rdsMutexKV = mutexkv.NewMutexKV()
+
+const (
+	rdsEngineMySQL      = "mysql"
+	rdsEnginePostgreSQL = "postgresql"
+
+	rdsPageLimit = 100
+)
+
+// Database represents a database of the RDSv3 instance.
+type Database struct {
+	Name         string `json:"name"`
+	CharacterSet string `json:"character_set"`
+	Owner        string `json:"owner"`
+	CollateSet   string `json:"collate_set"`
+}
+
+// CreateDatabaseOpts contains all the values needed to create a database.
+// `Owner`, `Template`, `LcCollate` and `LcCtype` are supported by PostgreSQL only.
+type CreateDatabaseOpts struct {
+	Name         string `json:"name" required:"true"`
+	CharacterSet string `json:"character_set,omitempty"`
+	Owner        string `json:"owner,omitempty"`
+	Template     string `json:"template,omitempty"`
+	LcCollate    string `json:"lc_collate,omitempty"`
+	LcCtype      string `json:"lc_ctype,omitempty"`
+}
+
+// DbUser represents a database account of the RDSv3 instance.
+type DbUser struct {
+	Name      string             `json:"name"`
+	Databases []DbUserPrivileges `json:"databases"`
+}
+
+// DbUserPrivileges represents privileges of the account for a single database (MySQL only).
+type DbUserPrivileges struct {
+	Name     string `json:"name"`
+	Readonly bool   `json:"readonly"`
+}
+
+// CreateDbUserOpts contains all the values needed to create a database account.
+type CreateDbUserOpts struct {
+	Name     string `json:"name" required:"true"`
+	Password string `json:"password" required:"true"`
+}
+
+// PrivilegeUser represents the account privilege level for a database.
+// `SchemaName` is required by PostgreSQL only.
+type PrivilegeUser struct {
+	Name       string `json:"name" required:"true"`
+	Readonly   bool   `json:"readonly"`
+	SchemaName string `json:"schema_name,omitempty"`
+}
+
+// rdsRequestOpts returns new request options for each call as the client modifies them
+func rdsRequestOpts() *golangsdk.RequestOpts {
+	return &golangsdk.RequestOpts{
+		OkCodes:     []int{200, 202},
+		MoreHeaders: map[string]string{"Content-Type": "application/json", "X-Language": "en-us"},
+	}
+}
+
+func getRdsEngine(client *golangsdk.ServiceClient, instanceID string) (string, error) {
+	instance, err := GetRdsInstance(client, instanceID)
+	if err != nil {
+		return "", err
+	}
+	if instance == nil {
+		return "", fmt.Errorf("RDSv3 instance %s not found", instanceID)
+	}
+	return strings.ToLower(instance.DataStore.Type), nil
+}
+
+// waitForRdsInstanceAvailable waits for the instance referenced by `instance_id` to become available
+func waitForRdsInstanceAvailable(client *golangsdk.ServiceClient, d *schema.ResourceData, timeoutKey string) {
+	timeout := d.Timeout(timeoutKey)
+	if err := instances.WaitForStateAvailable(client, int(timeout.Seconds()), d.Get("instance_id").(string)); err != nil {
+		log.Printf("Status available wasn't present")
+	}
+}
+
+func createDatabase(client *golangsdk.ServiceClient, instanceID string, opts CreateDatabaseOpts) error {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return err
+	}
+	_, err = client.Post(client.ServiceURL("instances", instanceID, "database"), b, nil, rdsRequestOpts())
+	return err
+}
+
+func listDatabases(client *golangsdk.ServiceClient, instanceID string) ([]Database, error) {
+	var result []Database
+	for page := 1; ; page++ {
+		var res struct {
+			Databases []Database `json:"databases"`
+		}
+		u := fmt.Sprintf("%s?page=%d&limit=%d", client.ServiceURL("instances", instanceID, "database", "detail"), page, rdsPageLimit)
+		if _, err := client.Get(u, &res, rdsRequestOpts()); err != nil {
+			return nil, err
+		}
+		result = append(result, res.Databases...)
+		if len(res.Databases) < rdsPageLimit {
+			return result, nil
+		}
+	}
+}
+
+// GetRdsDatabase returns the database by its name, `nil` is returned if the database doesn't exist
+func GetRdsDatabase(client *golangsdk.ServiceClient, instanceID, name string) (*Database, error) {
+	databases, err := listDatabases(client, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	for _, db := range databases {
+		if db.Name == name {
+			return &db, nil
+		}
+	}
+	return nil, nil
+}
+
+func deleteDatabase(client *golangsdk.ServiceClient, instanceID, name string) error {
+	_, err := client.Delete(client.ServiceURL("instances", instanceID, "database", name), rdsRequestOpts())
+	return err
+}
+
+func createDbUser(client *golangsdk.ServiceClient, instanceID string, opts CreateDbUserOpts) error {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return err
+	}
+	_, err = client.Post(client.ServiceURL("instances", instanceID, "db_user"), b, nil, rdsRequestOpts())
+	return err
+}
+
+func listDbUsers(client *golangsdk.ServiceClient, instanceID string) ([]DbUser, error) {
+	var result []DbUser
+	for page := 1; ; page++ {
+		var res struct {
+			Users []DbUser `json:"users"`
+		}
+		u := fmt.Sprintf("%s?page=%d&limit=%d", client.ServiceURL("instances", instanceID, "db_user", "detail"), page, rdsPageLimit)
+		if _, err := client.Get(u, &res, rdsRequestOpts()); err != nil {
+			return nil, err
+		}
+		result = append(result, res.Users...)
+		if len(res.Users) < rdsPageLimit {
+			return result, nil
+		}
+	}
+}
+
+// GetRdsDbUser returns the database account by its name, `nil` is returned if the account doesn't exist
+func GetRdsDbUser(client *golangsdk.ServiceClient, instanceID, name string) (*DbUser, error) {
+	users, err := listDbUsers(client, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	for _, user := range users {
+		if user.Name == name {
+			return &user, nil
+		}
+	}
+	return nil, nil
+}
+
+func deleteDbUser(client *golangsdk.ServiceClient, instanceID, name string) error {
+	_, err := client.Delete(client.ServiceURL("instances", instanceID, "db_user", name), rdsRequestOpts())
+	return err
+}
+
+func resetDbUserPassword(client *golangsdk.ServiceClient, instanceID string, opts CreateDbUserOpts) error {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return err
+	}
+	_, err = client.Post(client.ServiceURL("instances", instanceID, "db_user", "resetpwd"), b, nil, rdsRequestOpts())
+	return err
+}
+
+func grantDbPrivilege(client *golangsdk.ServiceClient, instanceID, dbName string, users []PrivilegeUser) error {
+	b := map[string]interface{}{
+		"db_name": dbName,
+		"users":   users,
+	}
+	_, err := client.Post(client.ServiceURL("instances", instanceID, "db_privilege"), b, nil, rdsRequestOpts())
+	return err
+}
+
+func revokeDbPrivilege(client *golangsdk.ServiceClient, instanceID, dbName string, users []PrivilegeUser) error {
+	names := make([]map[string]string, len(users))
+	for i, user := range users {
+		names[i] = map[string]string{"name": user.Name}
+	}
+	opts := rdsRequestOpts()
+	opts.JSONBody = map[string]interface{}{
+		"db_name": dbName,
+		"users":   names,
+	}
+	_, err := client.Request("DELETE", client.ServiceURL("instances", instanceID, "db_privilege"), opts)
+	return err
+}
+
+// listDatabaseUsers returns accounts authorized for the database (MySQL only)
+func listDatabaseUsers(client *golangsdk.ServiceClient, instanceID, dbName string) ([]PrivilegeUser, error) {
+	var result []PrivilegeUser
+	for page := 1; ; page++ {
+		var res struct {
+			Users []PrivilegeUser `json:"users"`
+		}
+		u := fmt.Sprintf("%s?db-name=%s&page=%d&limit=%d",
+			client.ServiceURL("instances", instanceID, "database", "db_user"), url.QueryEscape(dbName), page, rdsPageLimit)
+		if _, err := client.Get(u, &res, rdsRequestOpts()); err != nil {
+			return nil, err
+		}
+		result = append(result, res.Users...)
+		if len(res.Users) < rdsPageLimit {
+			return result, nil
+		}
+	}
+}
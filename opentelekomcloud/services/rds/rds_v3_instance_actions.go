@@ -0,0 +1,115 @@
+package rds
+
+import (
+	"fmt"
+	"strings"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// MaintenanceWindow represents RDSv3 instance maintenance window in `HH:MM` UTC format.
+type MaintenanceWindow struct {
+	StartTime string `json:"start_time" required:"true"`
+	EndTime   string `json:"end_time" required:"true"`
+}
+
+// parseMaintenanceWindow parses `HH:MM-HH:MM` string returned by the instance API
+func parseMaintenanceWindow(window string) (*MaintenanceWindow, error) {
+	parts := strings.Split(window, "-")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid maintenance window format: %s", window)
+	}
+	return &MaintenanceWindow{StartTime: parts[0], EndTime: parts[1]}, nil
+}
+
+func rdsInstanceAction(client *golangsdk.ServiceClient, method string, body interface{}, parts ...string) error {
+	opts := rdsRequestOpts()
+	opts.JSONBody = body
+	_, err := client.Request(method, client.ServiceURL(parts...), opts)
+	return err
+}
+
+func updatePort(client *golangsdk.ServiceClient, instanceID string, port int) error {
+	body := map[string]interface{}{"port": port}
+	return rdsInstanceAction(client, "PUT", body, "instances", instanceID, "port")
+}
+
+func updateSecurityGroup(client *golangsdk.ServiceClient, instanceID, securityGroupID string) error {
+	body := map[string]interface{}{"security_group_id": securityGroupID}
+	return rdsInstanceAction(client, "PUT", body, "instances", instanceID, "security-group")
+}
+
+func updateSSL(client *golangsdk.ServiceClient, instanceID string, enabled bool) error {
+	body := map[string]interface{}{"ssl_option": enabled}
+	return rdsInstanceAction(client, "PUT", body, "instances", instanceID, "ssl")
+}
+
+func updateMaintenanceWindow(client *golangsdk.ServiceClient, instanceID string, window MaintenanceWindow) error {
+	body, err := golangsdk.BuildRequestBody(window, "")
+	if err != nil {
+		return err
+	}
+	return rdsInstanceAction(client, "PUT", body, "instances", instanceID, "ops-window")
+}
+
+func updateReplicationMode(client *golangsdk.ServiceClient, instanceID, mode string) error {
+	body := map[string]interface{}{"mode": mode}
+	return rdsInstanceAction(client, "PUT", body, "instances", instanceID, "failover", "mode")
+}
+
+func upgradeMinorVersion(client *golangsdk.ServiceClient, instanceID string) error {
+	body := map[string]interface{}{"is_delayed": false}
+	return rdsInstanceAction(client, "POST", body, "instances", instanceID, "db-upgrade")
+}
+
+// restartInstance reboots the instance and returns ID of the restart job
+func restartInstance(client *golangsdk.ServiceClient, instanceID string) (string, error) {
+	var res struct {
+		JobID string `json:"job_id"`
+	}
+	body := map[string]interface{}{"restart": map[string]interface{}{}}
+	_, err := client.Post(client.ServiceURL("instances", instanceID, "action"), body, &res, rdsRequestOpts())
+	if err != nil {
+		return "", err
+	}
+	return res.JobID, nil
+}
+
+// getRdsInstanceSSLEnabled returns SSL status of the instance, it's missing in the instance structure of the SDK,
+// `nil` is returned if the status isn't reported for the engine
+func getRdsInstanceSSLEnabled(client *golangsdk.ServiceClient, instanceID string) (*bool, error) {
+	var res struct {
+		Instances []struct {
+			EnableSSL *bool `json:"enable_ssl"`
+		} `json:"instances"`
+	}
+	u := fmt.Sprintf("%s?id=%s", client.ServiceURL("instances"), instanceID)
+	if _, err := client.Get(u, &res, rdsRequestOpts()); err != nil {
+		return nil, err
+	}
+	if len(res.Instances) == 0 {
+		return nil, nil
+	}
+	return res.Instances[0].EnableSSL, nil
+}
+
+// getRdsInstancePendingRestart checks the parameter change history of the instance,
+// the restart is pending if any successful change isn't applied yet
+func getRdsInstancePendingRestart(client *golangsdk.ServiceClient, instanceID string) (bool, error) {
+	var res struct {
+		Histories []struct {
+			Name         string `json:"parameter_name"`
+			UpdateResult string `json:"update_result"`
+			Applied      bool   `json:"applied"`
+		} `json:"histories"`
+	}
+	if _, err := client.Get(client.ServiceURL("instances", instanceID, "parameter-history"), &res, rdsRequestOpts()); err != nil {
+		return false, err
+	}
+	for _, history := range res.Histories {
+		if strings.EqualFold(history.UpdateResult, "success") && !history.Applied {
+			return true, nil
+		}
+	}
+	return false, nil
+}
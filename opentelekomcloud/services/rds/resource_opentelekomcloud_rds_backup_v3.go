@@ -0,0 +1,219 @@
+package rds
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/rds/v3/instances"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceRdsBackupV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRdsBackupV3Create,
+		Read:   resourceRdsBackupV3Read,
+		Delete: resourceRdsBackupV3Delete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceRdsBackupV3Import,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"databases": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"size": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"begin_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func rdsBackupV3StateRefreshFunc(client *golangsdk.ServiceClient, instanceID, backupID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		backup, err := GetRdsBackup(client, instanceID, backupID)
+		if err != nil {
+			return nil, "", err
+		}
+		if backup == nil {
+			return Backup{}, "DELETED", nil
+		}
+		return backup, backup.Status, nil
+	}
+}
+
+func resourceRdsBackupV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.RdsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud RDSv3 client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	var databases []BackupDatabase
+	for _, name := range common.ExpandToStringSlice(d.Get("databases").([]interface{})) {
+		databases = append(databases, BackupDatabase{Name: name})
+	}
+	createOpts := CreateBackupOpts{
+		InstanceID:  instanceID,
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Databases:   databases,
+	}
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+
+	log.Printf("Manual backup could be created only in status `available`")
+	if err := instances.WaitForStateAvailable(client, 1200, instanceID); err != nil {
+		log.Printf("Status available wasn't present")
+	}
+
+	backup, err := createBackup(client, createOpts)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud RDSv3 backup: %s", err)
+	}
+	d.SetId(backup.ID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"BUILDING"},
+		Target:     []string{"COMPLETED"},
+		Refresh:    rdsBackupV3StateRefreshFunc(client, instanceID, backup.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for RDSv3 backup (%s) to become ready: %s", backup.ID, err)
+	}
+
+	return resourceRdsBackupV3Read(d, meta)
+}
+
+func resourceRdsBackupV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.RdsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud RDSv3 client: %s", err)
+	}
+
+	backup, err := GetRdsBackup(client, d.Get("instance_id").(string), d.Id())
+	if err != nil {
+		return fmt.Errorf("error fetching RDSv3 backup: %s", err)
+	}
+	if backup == nil {
+		log.Printf("[WARN] RDSv3 backup %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	databases := make([]string, len(backup.Databases))
+	for i, db := range backup.Databases {
+		databases[i] = db.Name
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("instance_id", backup.InstanceID),
+		d.Set("name", backup.Name),
+		d.Set("description", backup.Description),
+		d.Set("databases", databases),
+		d.Set("type", backup.Type),
+		d.Set("size", backup.Size),
+		d.Set("status", backup.Status),
+		d.Set("begin_time", backup.BeginTime),
+		d.Set("end_time", backup.EndTime),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmt.Errorf("error setting RDSv3 backup fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceRdsBackupV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.RdsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud RDSv3 client: %s", err)
+	}
+
+	log.Printf("[DEBUG] Deleting RDSv3 backup %s", d.Id())
+	if err := deleteBackup(client, d.Id()); err != nil {
+		return common.CheckDeleted(d, err, "error deleting OpenTelekomCloud RDSv3 backup")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"COMPLETED", "DELETING"},
+		Target:     []string{"DELETED"},
+		Refresh:    rdsBackupV3StateRefreshFunc(client, d.Get("instance_id").(string), d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for RDSv3 backup (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceRdsBackupV3Import(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for RDSv3 backup, must be <instance_id>/<backup_id>")
+	}
+	d.SetId(parts[1])
+	if err := d.Set("instance_id", parts[0]); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
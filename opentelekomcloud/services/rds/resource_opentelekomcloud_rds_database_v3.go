@@ -0,0 +1,210 @@
+package rds
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceRdsDatabaseV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRdsDatabaseV3Create,
+		Read:   resourceRdsDatabaseV3Read,
+		Delete: resourceRdsDatabaseV3Delete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceRdsDatabaseV3Import,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"character_set": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"owner": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"template": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressRdsDatabaseImportedDiffs,
+			},
+			"lc_collate": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressRdsDatabaseImportedDiffs,
+			},
+			"lc_ctype": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressRdsDatabaseImportedDiffs,
+			},
+		},
+	}
+}
+
+// suppressRdsDatabaseImportedDiffs suppresses the diff of the arguments not returned by the API,
+// they are empty in the state of the imported database and mustn't cause its recreation
+func suppressRdsDatabaseImportedDiffs(_, old, _ string, d *schema.ResourceData) bool {
+	return d.Id() != "" && old == ""
+}
+
+func resourceRdsDatabaseV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.RdsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud RDSv3 client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	engine, err := getRdsEngine(client, instanceID)
+	if err != nil {
+		return err
+	}
+
+	createOpts := CreateDatabaseOpts{
+		Name:         d.Get("name").(string),
+		CharacterSet: d.Get("character_set").(string),
+	}
+	switch engine {
+	case rdsEngineMySQL:
+		if createOpts.CharacterSet == "" {
+			return fmt.Errorf("`character_set` is required for MySQL databases")
+		}
+		for _, key := range []string{"owner", "template", "lc_collate", "lc_ctype"} {
+			if d.Get(key).(string) != "" {
+				return fmt.Errorf("`%s` is supported for PostgreSQL databases only", key)
+			}
+		}
+	case rdsEnginePostgreSQL:
+		createOpts.Owner = d.Get("owner").(string)
+		createOpts.Template = d.Get("template").(string)
+		createOpts.LcCollate = d.Get("lc_collate").(string)
+		createOpts.LcCtype = d.Get("lc_ctype").(string)
+	default:
+		return fmt.Errorf("database management is not supported for %s engine", engine)
+	}
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+
+	rdsMutexKV.Lock(instanceID)
+	defer rdsMutexKV.Unlock(instanceID)
+
+	waitForRdsInstanceAvailable(client, d, schema.TimeoutCreate)
+
+	if err := createDatabase(client, instanceID, createOpts); err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud RDSv3 database: %s", err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, createOpts.Name))
+
+	return resourceRdsDatabaseV3Read(d, meta)
+}
+
+func resourceRdsDatabaseV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.RdsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud RDSv3 client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	name := d.Get("name").(string)
+	database, err := GetRdsDatabase(client, instanceID, name)
+	if err != nil {
+		return common.CheckDeleted(d, err, "error fetching RDSv3 database")
+	}
+	if database == nil {
+		log.Printf("[WARN] RDSv3 database %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("name", database.Name),
+		d.Set("character_set", database.CharacterSet),
+		d.Set("owner", database.Owner),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmt.Errorf("error setting RDSv3 database fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceRdsDatabaseV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.RdsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud RDSv3 client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	rdsMutexKV.Lock(instanceID)
+	defer rdsMutexKV.Unlock(instanceID)
+
+	waitForRdsInstanceAvailable(client, d, schema.TimeoutDelete)
+
+	log.Printf("[DEBUG] Deleting RDSv3 database %s", d.Id())
+	if err := deleteDatabase(client, instanceID, d.Get("name").(string)); err != nil {
+		return common.CheckDeleted(d, err, "error deleting OpenTelekomCloud RDSv3 database")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// parseRdsResourceID splits `<instance_id>/<name>` ID used by RDSv3 database management resources
+func parseRdsResourceID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid ID format, must be <instance_id>/<name>")
+	}
+	return parts[0], parts[1], nil
+}
+
+func resourceRdsDatabaseV3Import(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	instanceID, name, err := parseRdsResourceID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	mErr := multierror.Append(nil,
+		d.Set("instance_id", instanceID),
+		d.Set("name", name),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
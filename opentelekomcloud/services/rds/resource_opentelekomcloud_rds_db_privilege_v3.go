@@ -0,0 +1,350 @@
+package rds
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceRdsDbPrivilegeV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRdsDbPrivilegeV3Create,
+		Read:   resourceRdsDbPrivilegeV3Read,
+		Update: resourceRdsDbPrivilegeV3Update,
+		Delete: resourceRdsDbPrivilegeV3Delete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceRdsDbPrivilegeV3Import,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"db_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"users": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"readonly": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"schema_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandRdsPrivilegeUsers(set *schema.Set) []PrivilegeUser {
+	users := make([]PrivilegeUser, 0, set.Len())
+	for _, raw := range set.List() {
+		user := raw.(map[string]interface{})
+		users = append(users, PrivilegeUser{
+			Name:       user["name"].(string),
+			Readonly:   user["readonly"].(bool),
+			SchemaName: user["schema_name"].(string),
+		})
+	}
+	return users
+}
+
+func validateRdsPrivilegeUsers(engine string, users []PrivilegeUser) error {
+	for _, user := range users {
+		switch engine {
+		case rdsEngineMySQL:
+			if user.SchemaName != "" {
+				return fmt.Errorf("`schema_name` is supported for PostgreSQL databases only")
+			}
+		case rdsEnginePostgreSQL:
+			if user.SchemaName == "" {
+				return fmt.Errorf("`schema_name` is required for PostgreSQL databases")
+			}
+		default:
+			return fmt.Errorf("privilege management is not supported for %s engine", engine)
+		}
+	}
+	return nil
+}
+
+func resourceRdsDbPrivilegeV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.RdsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud RDSv3 client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	dbName := d.Get("db_name").(string)
+	engine, err := getRdsEngine(client, instanceID)
+	if err != nil {
+		return err
+	}
+	users := expandRdsPrivilegeUsers(d.Get("users").(*schema.Set))
+	if err := validateRdsPrivilegeUsers(engine, users); err != nil {
+		return err
+	}
+
+	rdsMutexKV.Lock(instanceID)
+	defer rdsMutexKV.Unlock(instanceID)
+	waitForRdsInstanceAvailable(client, d, schema.TimeoutCreate)
+
+	log.Printf("[DEBUG] Granting RDSv3 privileges on %s: %#v", dbName, users)
+	if err := grantDbPrivilege(client, instanceID, dbName, users); err != nil {
+		return fmt.Errorf("error granting OpenTelekomCloud RDSv3 database privileges: %s", err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, dbName))
+
+	return resourceRdsDbPrivilegeV3Read(d, meta)
+}
+
+func resourceRdsDbPrivilegeV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.RdsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud RDSv3 client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	dbName := d.Get("db_name").(string)
+	database, err := GetRdsDatabase(client, instanceID, dbName)
+	if err != nil {
+		return common.CheckDeleted(d, err, "error fetching RDSv3 database")
+	}
+	if database == nil {
+		log.Printf("[WARN] RDSv3 database %s not found, removing privileges from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	engine, err := getRdsEngine(client, instanceID)
+	if err != nil {
+		return err
+	}
+	users, err := readRdsPrivilegeUsers(client, engine, instanceID, dbName, expandRdsPrivilegeUsers(d.Get("users").(*schema.Set)))
+	if err != nil {
+		return fmt.Errorf("error fetching RDSv3 database privileges: %s", err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("db_name", database.Name),
+		d.Set("users", users),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmt.Errorf("error setting RDSv3 database privileges fields: %s", err)
+	}
+
+	return nil
+}
+
+// readRdsPrivilegeUsers returns the actual privileges of the managed users,
+// accounts authorized for the database outside of the resource are ignored
+func readRdsPrivilegeUsers(client *golangsdk.ServiceClient, engine, instanceID, dbName string, managed []PrivilegeUser) ([]map[string]interface{}, error) {
+	var users []map[string]interface{}
+	if engine != rdsEngineMySQL {
+		// PostgreSQL API doesn't provide a way to list privileges of the database,
+		// so only the accounts removed from the instance are detected
+		accounts, err := listDbUsers(client, instanceID)
+		if err != nil {
+			return nil, err
+		}
+		existing := make(map[string]bool)
+		for _, account := range accounts {
+			existing[account.Name] = true
+		}
+		for _, user := range managed {
+			if !existing[user.Name] {
+				continue
+			}
+			users = append(users, map[string]interface{}{
+				"name":        user.Name,
+				"readonly":    user.Readonly,
+				"schema_name": user.SchemaName,
+			})
+		}
+		return users, nil
+	}
+
+	authorized, err := listDatabaseUsers(client, instanceID, dbName)
+	if err != nil {
+		return nil, err
+	}
+	managedNames := make(map[string]bool)
+	for _, user := range managed {
+		managedNames[user.Name] = true
+	}
+	for _, user := range authorized {
+		if !managedNames[user.Name] {
+			continue
+		}
+		users = append(users, map[string]interface{}{
+			"name":        user.Name,
+			"readonly":    user.Readonly,
+			"schema_name": "",
+		})
+	}
+	return users, nil
+}
+
+func resourceRdsDbPrivilegeV3Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.RdsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud RDSv3 client: %s", err)
+	}
+
+	if d.HasChange("users") {
+		instanceID := d.Get("instance_id").(string)
+		dbName := d.Get("db_name").(string)
+		engine, err := getRdsEngine(client, instanceID)
+		if err != nil {
+			return err
+		}
+
+		oldRaw, newRaw := d.GetChange("users")
+		oldSet := oldRaw.(*schema.Set)
+		newSet := newRaw.(*schema.Set)
+		// changed privilege level is applied as revoke of the old one and grant of the new one
+		revoke := expandRdsPrivilegeUsers(oldSet.Difference(newSet))
+		grant := expandRdsPrivilegeUsers(newSet.Difference(oldSet))
+		if err := validateRdsPrivilegeUsers(engine, grant); err != nil {
+			return err
+		}
+
+		rdsMutexKV.Lock(instanceID)
+		defer rdsMutexKV.Unlock(instanceID)
+
+		if len(revoke) > 0 {
+			waitForRdsInstanceAvailable(client, d, schema.TimeoutUpdate)
+			log.Printf("[DEBUG] Revoking RDSv3 privileges on %s: %#v", dbName, revoke)
+			if err := revokeDbPrivilege(client, instanceID, dbName, revoke); err != nil {
+				return fmt.Errorf("error revoking OpenTelekomCloud RDSv3 database privileges: %s", err)
+			}
+		}
+		if len(grant) > 0 {
+			waitForRdsInstanceAvailable(client, d, schema.TimeoutUpdate)
+			log.Printf("[DEBUG] Granting RDSv3 privileges on %s: %#v", dbName, grant)
+			if err := grantDbPrivilege(client, instanceID, dbName, grant); err != nil {
+				return fmt.Errorf("error granting OpenTelekomCloud RDSv3 database privileges: %s", err)
+			}
+		}
+	}
+
+	return resourceRdsDbPrivilegeV3Read(d, meta)
+}
+
+func resourceRdsDbPrivilegeV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.RdsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud RDSv3 client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	rdsMutexKV.Lock(instanceID)
+	defer rdsMutexKV.Unlock(instanceID)
+	waitForRdsInstanceAvailable(client, d, schema.TimeoutDelete)
+
+	users := expandRdsPrivilegeUsers(d.Get("users").(*schema.Set))
+	log.Printf("[DEBUG] Revoking RDSv3 privileges %s", d.Id())
+	if err := revokeDbPrivilege(client, instanceID, d.Get("db_name").(string), users); err != nil {
+		return common.CheckDeleted(d, err, "error revoking OpenTelekomCloud RDSv3 database privileges")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceRdsDbPrivilegeV3Import imports the privileges of the accounts listed in the ID:
+// `<instance_id>/<db_name>/<user>[:<schema_name>][,<user>[:<schema_name>]...]`,
+// all accounts authorized for the MySQL database are imported if the list is omitted
+func resourceRdsDbPrivilegeV3Import(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 3)
+	instanceID, dbName, err := parseRdsResourceID(strings.Join(parts[:2], "/"))
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, dbName))
+
+	var users []map[string]interface{}
+	if len(parts) == 3 {
+		for _, user := range strings.Split(parts[2], ",") {
+			userParts := strings.SplitN(user, ":", 2)
+			schemaName := ""
+			if len(userParts) == 2 {
+				schemaName = userParts[1]
+			}
+			users = append(users, map[string]interface{}{
+				"name":        userParts[0],
+				"readonly":    false,
+				"schema_name": schemaName,
+			})
+		}
+	} else {
+		config := meta.(*cfg.Config)
+		client, err := config.RdsV3Client(config.GetRegion(d))
+		if err != nil {
+			return nil, fmt.Errorf("error creating OpenTelekomCloud RDSv3 client: %s", err)
+		}
+		engine, err := getRdsEngine(client, instanceID)
+		if err != nil {
+			return nil, err
+		}
+		if engine != rdsEngineMySQL {
+			return nil, fmt.Errorf("accounts must be listed in the ID for %s databases: "+
+				"<instance_id>/<db_name>/<user>:<schema_name>[,<user>:<schema_name>...]", engine)
+		}
+		authorized, err := listDatabaseUsers(client, instanceID, dbName)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching RDSv3 database privileges: %s", err)
+		}
+		for _, user := range authorized {
+			users = append(users, map[string]interface{}{
+				"name":        user.Name,
+				"readonly":    user.Readonly,
+				"schema_name": "",
+			})
+		}
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("instance_id", instanceID),
+		d.Set("db_name", dbName),
+		d.Set("users", users),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,172 @@
+package rds
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceRdsDbUserV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRdsDbUserV3Create,
+		Read:   resourceRdsDbUserV3Read,
+		Update: resourceRdsDbUserV3Update,
+		Delete: resourceRdsDbUserV3Delete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceRdsDbUserV3Import,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceRdsDbUserV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.RdsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud RDSv3 client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	engine, err := getRdsEngine(client, instanceID)
+	if err != nil {
+		return err
+	}
+	if engine != rdsEngineMySQL && engine != rdsEnginePostgreSQL {
+		return fmt.Errorf("database account management is not supported for %s engine", engine)
+	}
+
+	createOpts := CreateDbUserOpts{
+		Name:     d.Get("name").(string),
+		Password: d.Get("password").(string),
+	}
+
+	rdsMutexKV.Lock(instanceID)
+	defer rdsMutexKV.Unlock(instanceID)
+
+	waitForRdsInstanceAvailable(client, d, schema.TimeoutCreate)
+
+	log.Printf("[DEBUG] Creating RDSv3 database account %s", createOpts.Name)
+	if err := createDbUser(client, instanceID, createOpts); err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud RDSv3 database account: %s", err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, createOpts.Name))
+
+	return resourceRdsDbUserV3Read(d, meta)
+}
+
+func resourceRdsDbUserV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.RdsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud RDSv3 client: %s", err)
+	}
+
+	user, err := GetRdsDbUser(client, d.Get("instance_id").(string), d.Get("name").(string))
+	if err != nil {
+		return common.CheckDeleted(d, err, "error fetching RDSv3 database account")
+	}
+	if user == nil {
+		log.Printf("[WARN] RDSv3 database account %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("name", user.Name); err != nil {
+		return fmt.Errorf("error setting RDSv3 database account name: %s", err)
+	}
+
+	return nil
+}
+
+func resourceRdsDbUserV3Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.RdsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud RDSv3 client: %s", err)
+	}
+
+	if d.HasChange("password") {
+		instanceID := d.Get("instance_id").(string)
+		rdsMutexKV.Lock(instanceID)
+		defer rdsMutexKV.Unlock(instanceID)
+
+		waitForRdsInstanceAvailable(client, d, schema.TimeoutUpdate)
+
+		resetOpts := CreateDbUserOpts{
+			Name:     d.Get("name").(string),
+			Password: d.Get("password").(string),
+		}
+		if err := resetDbUserPassword(client, instanceID, resetOpts); err != nil {
+			return fmt.Errorf("error resetting OpenTelekomCloud RDSv3 database account password: %s", err)
+		}
+	}
+
+	return resourceRdsDbUserV3Read(d, meta)
+}
+
+func resourceRdsDbUserV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.RdsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud RDSv3 client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	rdsMutexKV.Lock(instanceID)
+	defer rdsMutexKV.Unlock(instanceID)
+
+	waitForRdsInstanceAvailable(client, d, schema.TimeoutDelete)
+
+	log.Printf("[DEBUG] Deleting RDSv3 database account %s", d.Id())
+	if err := deleteDbUser(client, instanceID, d.Get("name").(string)); err != nil {
+		return common.CheckDeleted(d, err, "error deleting OpenTelekomCloud RDSv3 database account")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceRdsDbUserV3Import(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	instanceID, name, err := parseRdsResourceID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	mErr := multierror.Append(nil,
+		d.Set("instance_id", instanceID),
+		d.Set("name", name),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/opentelekomcloud/gophertelekomcloud"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/subnets"
@@ -21,6 +22,7 @@ import (
 
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/eps"
 )
 
 func ResourceRdsInstanceV3() *schema.Resource {
@@ -39,7 +41,10 @@ func ResourceRdsInstanceV3() *schema.Resource {
 			Update: schema.DefaultTimeout(30 * time.Minute),
 		},
 
-		CustomizeDiff: validateRDSv3Version("db"),
+		CustomizeDiff: customdiff.All(
+			validateRDSv3Version("db"),
+			resourceRdsInstanceV3PendingRestartDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"availability_zone": {
@@ -53,7 +58,6 @@ func ResourceRdsInstanceV3() *schema.Resource {
 			"db": {
 				Type:     schema.TypeList,
 				Required: true,
-				ForceNew: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -77,7 +81,6 @@ func ResourceRdsInstanceV3() *schema.Resource {
 							Type:     schema.TypeInt,
 							Computed: true,
 							Optional: true,
-							ForceNew: true,
 						},
 						"user_name": {
 							Type:     schema.TypeString,
@@ -99,7 +102,6 @@ func ResourceRdsInstanceV3() *schema.Resource {
 			"security_group_id": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 			"subnet_id": {
 				Type:     schema.TypeString,
@@ -163,7 +165,33 @@ func ResourceRdsInstanceV3() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 				Optional: true,
-				ForceNew: true,
+			},
+			"maintenance_window": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start_time": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"end_time": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"ssl_enable": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"minor_version_upgrade": {
+				Type:     schema.TypeBool,
+				Optional: true,
 			},
 			"tag": {
 				Type:         schema.TypeMap,
@@ -174,6 +202,19 @@ func ResourceRdsInstanceV3() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"restart_on_param_group_change": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"pending_restart": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 			"created": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -222,6 +263,31 @@ func ResourceRdsInstanceV3() *schema.Resource {
 					ValidateFunc: common.ValidateIP,
 				},
 			},
+			"restore_point": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"backup_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"restore_time": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -269,6 +335,44 @@ func resourceRDSHa(d *schema.ResourceData) *instances.Ha {
 	return &ha
 }
 
+func resourceRDSRestorePoint(d *schema.ResourceData) (*RestorePoint, error) {
+	restorePointRaw := d.Get("restore_point").([]interface{})
+	if len(restorePointRaw) == 0 {
+		return nil, nil
+	}
+	restorePointInfo := restorePointRaw[0].(map[string]interface{})
+	restorePoint := RestorePoint{
+		InstanceID: restorePointInfo["instance_id"].(string),
+	}
+	backupID := restorePointInfo["backup_id"].(string)
+	restoreTime := restorePointInfo["restore_time"].(int)
+	switch {
+	case backupID != "" && restoreTime != 0:
+		return nil, fmt.Errorf("only one of `backup_id` and `restore_time` can be set in `restore_point`")
+	case backupID != "":
+		restorePoint.Type = "backup"
+		restorePoint.BackupID = backupID
+	case restoreTime != 0:
+		restorePoint.Type = "timestamp"
+		restorePoint.RestoreTime = int64(restoreTime)
+	default:
+		return nil, fmt.Errorf("one of `backup_id` and `restore_time` has to be set in `restore_point`")
+	}
+	return &restorePoint, nil
+}
+
+func resourceRDSMaintenanceWindow(d *schema.ResourceData) *MaintenanceWindow {
+	windowRaw := d.Get("maintenance_window").([]interface{})
+	if len(windowRaw) == 0 {
+		return nil
+	}
+	window := windowRaw[0].(map[string]interface{})
+	return &MaintenanceWindow{
+		StartTime: window["start_time"].(string),
+		EndTime:   window["end_time"].(string),
+	}
+}
+
 func resourceRDSChangeMode() *instances.ChargeInfo {
 	chargeInfo := instances.ChargeInfo{
 		ChargeMode: "postPaid",
@@ -308,23 +412,32 @@ func resourceRdsInstanceV3Create(d *schema.ResourceData, meta interface{}) error
 		dbPortString = ""
 	}
 
-	createOpts := instances.CreateRdsOpts{
-		Name:             d.Get("name").(string),
-		Datastore:        resourceRDSDataStore(d),
-		Ha:               resourceRDSHa(d),
-		ConfigurationId:  d.Get("param_group_id").(string),
-		Port:             dbPortString,
-		Password:         dbInfo["password"].(string),
-		BackupStrategy:   resourceRDSBackupStrategy(d),
-		DiskEncryptionId: volumeInfo["disk_encryption_id"].(string),
-		FlavorRef:        d.Get("flavor").(string),
-		Volume:           resourceRDSVolume(d),
-		Region:           config.GetRegion(d),
-		AvailabilityZone: resourceRDSAvailabilityZones(d),
-		VpcId:            d.Get("vpc_id").(string),
-		SubnetId:         d.Get("subnet_id").(string),
-		SecurityGroupId:  d.Get("security_group_id").(string),
-		ChargeInfo:       resourceRDSChangeMode(),
+	restorePoint, err := resourceRDSRestorePoint(d)
+	if err != nil {
+		return err
+	}
+
+	createOpts := CreateRdsOpts{
+		CreateRdsOpts: instances.CreateRdsOpts{
+			Name:                d.Get("name").(string),
+			Datastore:           resourceRDSDataStore(d),
+			Ha:                  resourceRDSHa(d),
+			ConfigurationId:     d.Get("param_group_id").(string),
+			Port:                dbPortString,
+			Password:            dbInfo["password"].(string),
+			BackupStrategy:      resourceRDSBackupStrategy(d),
+			EnterpriseProjectId: d.Get("enterprise_project_id").(string),
+			DiskEncryptionId:    volumeInfo["disk_encryption_id"].(string),
+			FlavorRef:           d.Get("flavor").(string),
+			Volume:              resourceRDSVolume(d),
+			Region:              config.GetRegion(d),
+			AvailabilityZone:    resourceRDSAvailabilityZones(d),
+			VpcId:               d.Get("vpc_id").(string),
+			SubnetId:            d.Get("subnet_id").(string),
+			SecurityGroupId:     d.Get("security_group_id").(string),
+			ChargeInfo:          resourceRDSChangeMode(),
+		},
+		RestorePoint: restorePoint,
 	}
 	createResult := instances.Create(client, createOpts)
 	r, err := createResult.Extract()
@@ -343,6 +456,18 @@ func resourceRdsInstanceV3Create(d *schema.ResourceData, meta interface{}) error
 
 	d.SetId(r.Instance.Id)
 
+	if d.Get("ssl_enable").(bool) {
+		if err := updateSSL(client, d.Id(), true); err != nil {
+			return fmt.Errorf("error enabling SSL for RDSv3 instance: %s", err)
+		}
+	}
+
+	if window := resourceRDSMaintenanceWindow(d); window != nil {
+		if err := updateMaintenanceWindow(client, d.Id(), *window); err != nil {
+			return fmt.Errorf("error setting RDSv3 instance maintenance window: %s", err)
+		}
+	}
+
 	if common.HasFilledOpt(d, "tag") {
 		rdsInstance, err := GetRdsInstance(client, r.Instance.Id)
 		if err != nil {
@@ -681,6 +806,76 @@ func resourceRdsInstanceV3Update(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	if d.HasChange("db.0.port") {
+		log.Printf("Update port could be done only in status `available`")
+		if err := instances.WaitForStateAvailable(client, 1200, d.Id()); err != nil {
+			log.Printf("Status available wasn't present")
+		}
+		if err := updatePort(client, d.Id(), d.Get("db.0.port").(int)); err != nil {
+			return fmt.Errorf("error updating RDSv3 instance port: %s", err)
+		}
+		log.Printf("Waiting for RDSv3 become in status `available`")
+		if err := instances.WaitForStateAvailable(client, 1200, d.Id()); err != nil {
+			log.Printf("Status available wasn't present")
+		}
+	}
+
+	if d.HasChange("security_group_id") {
+		if err := updateSecurityGroup(client, d.Id(), d.Get("security_group_id").(string)); err != nil {
+			return fmt.Errorf("error updating RDSv3 instance security group: %s", err)
+		}
+		log.Printf("Waiting for RDSv3 become in status `available`")
+		if err := instances.WaitForStateAvailable(client, 1200, d.Id()); err != nil {
+			log.Printf("Status available wasn't present")
+		}
+	}
+
+	if d.HasChange("ssl_enable") {
+		log.Printf("Update SSL could be done only in status `available`")
+		if err := instances.WaitForStateAvailable(client, 1200, d.Id()); err != nil {
+			log.Printf("Status available wasn't present")
+		}
+		if err := updateSSL(client, d.Id(), d.Get("ssl_enable").(bool)); err != nil {
+			return fmt.Errorf("error updating RDSv3 instance SSL: %s", err)
+		}
+		log.Printf("Waiting for RDSv3 become in status `available`")
+		if err := instances.WaitForStateAvailable(client, 1200, d.Id()); err != nil {
+			log.Printf("Status available wasn't present")
+		}
+	}
+
+	if d.HasChange("maintenance_window") {
+		if window := resourceRDSMaintenanceWindow(d); window != nil {
+			if err := updateMaintenanceWindow(client, d.Id(), *window); err != nil {
+				return fmt.Errorf("error updating RDSv3 instance maintenance window: %s", err)
+			}
+		}
+	}
+
+	if d.HasChange("ha_replication_mode") {
+		if err := updateReplicationMode(client, d.Id(), d.Get("ha_replication_mode").(string)); err != nil {
+			return fmt.Errorf("error updating RDSv3 instance replication mode: %s", err)
+		}
+		log.Printf("Waiting for RDSv3 become in status `available`")
+		if err := instances.WaitForStateAvailable(client, 1200, d.Id()); err != nil {
+			log.Printf("Status available wasn't present")
+		}
+	}
+
+	if d.HasChange("minor_version_upgrade") && d.Get("minor_version_upgrade").(bool) {
+		log.Printf("Minor version upgrade could be done only in status `available`")
+		if err := instances.WaitForStateAvailable(client, 1200, d.Id()); err != nil {
+			log.Printf("Status available wasn't present")
+		}
+		if err := upgradeMinorVersion(client, d.Id()); err != nil {
+			return fmt.Errorf("error upgrading RDSv3 instance minor version: %s", err)
+		}
+		log.Printf("Waiting for RDSv3 become in status `available`")
+		if err := instances.WaitForStateAvailable(client, 1200, d.Id()); err != nil {
+			log.Printf("Status available wasn't present")
+		}
+	}
+
 	if d.HasChange("param_group_id") {
 		newParamGroupID := d.Get("param_group_id").(string)
 		if len(newParamGroupID) == 0 {
@@ -691,14 +886,53 @@ func resourceRdsInstanceV3Update(d *schema.ResourceData, meta interface{}) error
 				d.Id(),
 			},
 		}
-		if err := configurations.Apply(client, newParamGroupID, applyOpts).Err; err != nil {
+		if _, err := configurations.Apply(client, newParamGroupID, applyOpts).Extract(); err != nil {
 			return fmt.Errorf("error during apply new configuration: %s", err)
 		}
 	}
 
+	// restart is also done for the parameter group changed outside of the resource
+	if d.Get("restart_on_param_group_change").(bool) {
+		restartPending, err := getRdsInstancePendingRestart(client, d.Id())
+		if err != nil {
+			return fmt.Errorf("error fetching RDSv3 instance parameter change history: %s", err)
+		}
+		if restartPending {
+			log.Printf("Restart could be done only in status `available`")
+			if err := instances.WaitForStateAvailable(client, 1200, d.Id()); err != nil {
+				log.Printf("Status available wasn't present")
+			}
+			jobID, err := restartInstance(client, d.Id())
+			if err != nil {
+				return fmt.Errorf("error restarting RDSv3 instance: %s", err)
+			}
+			timeout := d.Timeout(schema.TimeoutUpdate)
+			if err := instances.WaitForJobCompleted(client, int(timeout.Seconds()), jobID); err != nil {
+				return err
+			}
+		}
+	}
+
+	migrateOpts := eps.MigrateResourceOpts{
+		ResourceType: "rds",
+		ResourceID:   d.Id(),
+	}
+	if err := eps.MigrateResource(d, config, migrateOpts); err != nil {
+		return err
+	}
+
 	return resourceRdsInstanceV3Read(d, meta)
 }
 
+// resourceRdsInstanceV3PendingRestartDiff plans the restart of the instance having the parameter
+// change pending when `restart_on_param_group_change` is enabled
+func resourceRdsInstanceV3PendingRestartDiff(d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.Get("pending_restart").(bool) || !d.Get("restart_on_param_group_change").(bool) {
+		return nil
+	}
+	return d.SetNew("pending_restart", false)
+}
+
 func getMasterID(nodes []instances.Nodes) (nodeID string) {
 	for _, node := range nodes {
 		if node.Role == "master" {
@@ -732,6 +966,7 @@ func resourceRdsInstanceV3Read(d *schema.ResourceData, meta interface{}) error {
 		d.Set("vpc_id", rdsInstance.VpcId),
 		d.Set("created", rdsInstance.Created),
 		d.Set("ha_replication_mode", rdsInstance.Ha.ReplicationMode),
+		d.Set("enterprise_project_id", rdsInstance.EnterpriseProjectId),
 	)
 
 	if me.ErrorOrNil() != nil {
@@ -785,6 +1020,43 @@ func resourceRdsInstanceV3Read(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	sslEnabled, err := getRdsInstanceSSLEnabled(client, d.Id())
+	if err != nil {
+		return fmt.Errorf("error fetching RDSv3 instance SSL status: %s", err)
+	}
+	if sslEnabled != nil {
+		if err := d.Set("ssl_enable", *sslEnabled); err != nil {
+			return fmt.Errorf("error setting SSL status: %s", err)
+		}
+	}
+
+	restartPending, err := getRdsInstancePendingRestart(client, d.Id())
+	if err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); !ok {
+			return fmt.Errorf("error fetching RDSv3 instance parameter change history: %s", err)
+		}
+		log.Printf("[WARN] Parameter change history isn't available for RDSv3 instance %s", d.Id())
+	}
+	if err := d.Set("pending_restart", restartPending); err != nil {
+		return fmt.Errorf("error setting pending restart flag: %s", err)
+	}
+
+	if rdsInstance.MaintenanceWindow != "" {
+		window, err := parseMaintenanceWindow(rdsInstance.MaintenanceWindow)
+		if err != nil {
+			return err
+		}
+		windowList := []map[string]interface{}{
+			{
+				"start_time": window.StartTime,
+				"end_time":   window.EndTime,
+			},
+		}
+		if err := d.Set("maintenance_window", windowList); err != nil {
+			return fmt.Errorf("error setting maintenance window: %s", err)
+		}
+	}
+
 	if err = d.Set("private_ips", rdsInstance.PrivateIps); err != nil {
 		return err
 	}
@@ -0,0 +1,244 @@
+package rds
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/rds/v3/configurations"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/rds/v3/instances"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceRdsParameterGroupApplyV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRdsParameterGroupApplyV3Create,
+		Read:   resourceRdsParameterGroupApplyV3Read,
+		Update: resourceRdsParameterGroupApplyV3Update,
+		Delete: resourceRdsParameterGroupApplyV3Delete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: checkRdsParameterGroupUpdated,
+
+		Schema: map[string]*schema.Schema{
+			"config_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"instance_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"restart_if_required": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			"config_updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"success": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"restart_required": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"restarted": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// checkRdsParameterGroupUpdated plans re-applying of the parameter group if it was changed after the last apply
+func checkRdsParameterGroupUpdated(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || d.HasChange("config_id") {
+		return nil
+	}
+	config := meta.(*cfg.Config)
+	client, err := config.RdsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud RDSv3 client: %s", err)
+	}
+	configuration, err := configurations.Get(client, d.Get("config_id").(string)).Extract()
+	if err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); ok {
+			return nil
+		}
+		return fmt.Errorf("error retrieving OpenTelekomCloud RDSv3 configuration: %s", err)
+	}
+	if configuration.Updated != d.Get("config_updated").(string) {
+		return d.SetNewComputed("config_updated")
+	}
+	return nil
+}
+
+func applyRdsParameterGroup(d *schema.ResourceData, client *golangsdk.ServiceClient, timeoutKey string) error {
+	configID := d.Get("config_id").(string)
+	instanceIDs := common.ExpandToStringSlice(d.Get("instance_ids").(*schema.Set).List())
+	// keep the locking order stable to avoid deadlocks with other resources
+	sort.Strings(instanceIDs)
+	timeout := d.Timeout(timeoutKey)
+
+	configuration, err := configurations.Get(client, configID).Extract()
+	if err != nil {
+		return fmt.Errorf("error retrieving OpenTelekomCloud RDSv3 configuration: %s", err)
+	}
+	// the same parameter group can be applied by several resources to different instances
+	d.SetId(fmt.Sprintf("%s/%s", configID, strings.Join(instanceIDs, ",")))
+
+	for _, id := range instanceIDs {
+		rdsMutexKV.Lock(id)
+		defer rdsMutexKV.Unlock(id)
+
+		log.Printf("Apply configuration could be done only in status `available`")
+		if err := instances.WaitForStateAvailable(client, int(timeout.Seconds()), id); err != nil {
+			log.Printf("Status available wasn't present")
+		}
+	}
+
+	applyOpts := configurations.ApplyOpts{
+		InstanceIDs: instanceIDs,
+	}
+	log.Printf("[DEBUG] Applying RDSv3 configuration %s: %#v", configID, applyOpts)
+	applyResult, err := configurations.Apply(client, configID, applyOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("error applying OpenTelekomCloud RDSv3 configuration: %s", err)
+	}
+
+	restart := d.Get("restart_if_required").(bool)
+	results := make([]map[string]interface{}, len(applyResult.ApplyResults))
+	for i, result := range applyResult.ApplyResults {
+		log.Printf("Waiting for RDSv3 become in status `available`")
+		if err := instances.WaitForStateAvailable(client, int(timeout.Seconds()), result.InstanceID); err != nil {
+			log.Printf("Status available wasn't present")
+		}
+
+		restarted := false
+		if restart && result.Success && result.RestartRequired {
+			jobID, err := restartInstance(client, result.InstanceID)
+			if err != nil {
+				return fmt.Errorf("error restarting RDSv3 instance %s: %s", result.InstanceID, err)
+			}
+			if err := instances.WaitForJobCompleted(client, int(timeout.Seconds()), jobID); err != nil {
+				return err
+			}
+			restarted = true
+		}
+
+		results[i] = map[string]interface{}{
+			"instance_id":      result.InstanceID,
+			"instance_name":    result.InstanceName,
+			"success":          result.Success,
+			"restart_required": result.RestartRequired,
+			"restarted":        restarted,
+		}
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("results", results),
+		d.Set("config_updated", configuration.Updated),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmt.Errorf("error setting RDSv3 configuration apply fields: %s", err)
+	}
+
+	if !applyResult.Success {
+		return fmt.Errorf("RDSv3 configuration %s wasn't applied to all instances, check `results` for details", configID)
+	}
+	return nil
+}
+
+func resourceRdsParameterGroupApplyV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.RdsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud RDSv3 client: %s", err)
+	}
+
+	if err := applyRdsParameterGroup(d, client, schema.TimeoutCreate); err != nil {
+		return err
+	}
+
+	return resourceRdsParameterGroupApplyV3Read(d, meta)
+}
+
+func resourceRdsParameterGroupApplyV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.RdsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud RDSv3 client: %s", err)
+	}
+
+	_, err = configurations.Get(client, d.Get("config_id").(string)).Extract()
+	if err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); ok {
+			log.Printf("[WARN] RDSv3 configuration %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error retrieving OpenTelekomCloud RDSv3 configuration: %s", err)
+	}
+
+	return nil
+}
+
+func resourceRdsParameterGroupApplyV3Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.RdsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud RDSv3 client: %s", err)
+	}
+
+	if d.HasChanges("instance_ids", "triggers", "config_updated") {
+		if err := applyRdsParameterGroup(d, client, schema.TimeoutUpdate); err != nil {
+			return err
+		}
+	}
+
+	return resourceRdsParameterGroupApplyV3Read(d, meta)
+}
+
+func resourceRdsParameterGroupApplyV3Delete(d *schema.ResourceData, _ interface{}) error {
+	log.Printf("[DEBUG] RDSv3 configuration %s can't be detached from instances, removing from state only", d.Id())
+	d.SetId("")
+	return nil
+}
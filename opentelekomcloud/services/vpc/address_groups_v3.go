@@ -0,0 +1,90 @@
+package vpc
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// AddressGroup represents the IP address group.
+type AddressGroup struct {
+	ID          string                `json:"id"`
+	Name        string                `json:"name"`
+	Description string                `json:"description"`
+	IPVersion   int                   `json:"ip_version"`
+	MaxCapacity int                   `json:"max_capacity"`
+	IPSet       []string              `json:"ip_set"`
+	IPExtraSet  []AddressGroupIPEntry `json:"ip_extra_set"`
+	CreatedAt   string                `json:"created_at"`
+	UpdatedAt   string                `json:"updated_at"`
+}
+
+// AddressGroupIPEntry represents the IP address, CIDR or IP address range of the group with a description.
+type AddressGroupIPEntry struct {
+	IP      string `json:"ip"`
+	Remarks string `json:"remarks"`
+}
+
+// AddressGroupCreateOpts contains the values used for the IP address group creation.
+type AddressGroupCreateOpts struct {
+	Name        string                `json:"name" required:"true"`
+	Description string                `json:"description,omitempty"`
+	IPVersion   int                   `json:"ip_version" required:"true"`
+	MaxCapacity int                   `json:"max_capacity,omitempty"`
+	IPExtraSet  []AddressGroupIPEntry `json:"ip_extra_set,omitempty"`
+}
+
+// AddressGroupUpdateOpts contains the values used for the IP address group update,
+// `IPExtraSet` replaces all entries of the group.
+type AddressGroupUpdateOpts struct {
+	Name        string                 `json:"name,omitempty"`
+	Description *string                `json:"description,omitempty"`
+	MaxCapacity int                    `json:"max_capacity,omitempty"`
+	IPExtraSet  *[]AddressGroupIPEntry `json:"ip_extra_set,omitempty"`
+}
+
+func addressGroupURL(client *golangsdk.ServiceClient, parts ...string) string {
+	return client.ServiceURL(append([]string{client.ProjectID, "vpc", "address-groups"}, parts...)...)
+}
+
+func createAddressGroup(client *golangsdk.ServiceClient, opts AddressGroupCreateOpts) (*AddressGroup, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "address_group")
+	if err != nil {
+		return nil, err
+	}
+	var res struct {
+		AddressGroup AddressGroup `json:"address_group"`
+	}
+	if _, err := client.Post(addressGroupURL(client), b, &res, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	}); err != nil {
+		return nil, err
+	}
+	return &res.AddressGroup, nil
+}
+
+func getAddressGroup(client *golangsdk.ServiceClient, id string) (*AddressGroup, error) {
+	var res struct {
+		AddressGroup AddressGroup `json:"address_group"`
+	}
+	if _, err := client.Get(addressGroupURL(client, id), &res, nil); err != nil {
+		return nil, err
+	}
+	return &res.AddressGroup, nil
+}
+
+func updateAddressGroup(client *golangsdk.ServiceClient, id string, opts AddressGroupUpdateOpts) error {
+	b, err := golangsdk.BuildRequestBody(opts, "address_group")
+	if err != nil {
+		return err
+	}
+	_, err = client.Put(addressGroupURL(client, id), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func deleteAddressGroup(client *golangsdk.ServiceClient, id string) error {
+	_, err := client.Delete(addressGroupURL(client, id), &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return err
+}
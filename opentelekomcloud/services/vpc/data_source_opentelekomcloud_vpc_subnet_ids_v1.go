@@ -27,6 +27,10 @@ func DataSourceVpcSubnetIdsV1() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"ipv6_enable": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"ids": {
 				Type:     schema.TypeSet,
 				Computed: true,
@@ -53,6 +57,20 @@ func dataSourceVpcSubnetIdsV1Read(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("Unable to retrieve subnets: %s", err)
 	}
 
+	if ipv6Enable, ok := d.GetOkExists("ipv6_enable"); ok {
+		var ipv6Subnets []subnets.Subnet
+		for _, subnet := range refinedSubnets {
+			ipv6, err := ExtractVpcSubnetIPv6(subnets.Get(subnetClient, subnet.ID))
+			if err != nil {
+				return fmt.Errorf("error retrieving subnet IPv6 attributes: %s", err)
+			}
+			if ipv6.IPv6Enable == ipv6Enable.(bool) {
+				ipv6Subnets = append(ipv6Subnets, subnet)
+			}
+		}
+		refinedSubnets = ipv6Subnets
+	}
+
 	if len(refinedSubnets) == 0 {
 		return fmt.Errorf("no matching subnet found for vpc with id %s", d.Get("vpc_id").(string))
 	}
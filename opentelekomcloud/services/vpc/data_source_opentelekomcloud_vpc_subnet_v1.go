@@ -4,10 +4,12 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/subnets"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
 )
 
@@ -73,6 +75,38 @@ func DataSourceVpcSubnetV1() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"ntp_addresses": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dhcp_lease_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dhcp_domain_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ipv6_enable": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"ipv6_cidr": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ipv6_gateway": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ipv6_subnet_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -128,5 +162,29 @@ func dataSourceVpcSubnetV1Read(d *schema.ResourceData, meta interface{}) error {
 	d.Set("subnet_id", Subnets.SubnetId)
 	d.Set("region", config.GetRegion(d))
 
+	if err := setVpcSubnetExtraDhcpOpts(d, Subnets.ExtraDhcpOpts); err != nil {
+		return fmt.Errorf("error setting subnet extra DHCP options: %s", err)
+	}
+
+	ipv6, err := ExtractVpcSubnetIPv6(subnets.Get(subnetClient, Subnets.ID))
+	if err != nil {
+		return fmt.Errorf("error retrieving subnet IPv6 attributes: %s", err)
+	}
+	if err := setVpcSubnetIPv6(d, ipv6); err != nil {
+		return fmt.Errorf("error setting subnet IPv6 attributes: %s", err)
+	}
+
+	networkingClient, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud networking client: %s", err)
+	}
+	resourceTags, err := tags.Get(networkingClient, "subnets", d.Id()).Extract()
+	if err != nil {
+		return fmt.Errorf("error fetching OpenTelekomCloud subnet tags: %s", err)
+	}
+	if err := d.Set("tags", common.TagsToMap(resourceTags)); err != nil {
+		return fmt.Errorf("error saving tags for OpenTelekomCloud subnet %s: %s", d.Id(), err)
+	}
+
 	return nil
 }
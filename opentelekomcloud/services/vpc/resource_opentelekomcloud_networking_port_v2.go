@@ -4,11 +4,14 @@ import (
 	"bytes"
 	"fmt"
 	"log"
+	"net"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/opentelekomcloud/gophertelekomcloud"
 
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/ports"
@@ -103,8 +106,10 @@ func ResourceNetworkingPortV2() *schema.Resource {
 							Required: true,
 						},
 						"ip_address": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     validation.IsIPAddress,
+							DiffSuppressFunc: common.SuppressEquivalentIPDiffs,
 						},
 					},
 				},
@@ -139,6 +144,11 @@ func ResourceNetworkingPortV2() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"all_fixed_ipv6s": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
@@ -245,13 +255,19 @@ func resourceNetworkingPortV2Read(d *schema.ResourceData, meta interface{}) erro
 	// Create a slice of all returned Fixed IPs.
 	// This will be in the order returned by the API,
 	// which is usually alpha-numeric.
-	var ips []string
+	var ips, ipv6s []string
 	for _, ipObject := range p.FixedIPs {
 		ips = append(ips, ipObject.IPAddress)
+		if strings.Contains(ipObject.IPAddress, ":") {
+			ipv6s = append(ipv6s, ipObject.IPAddress)
+		}
 	}
 	if err := d.Set("all_fixed_ips", ips); err != nil {
 		return fmt.Errorf("[DEBUG] Error saving all_fixed_ips to state for OpenTelekomCloud port (%s): %s", d.Id(), err)
 	}
+	if err := d.Set("all_fixed_ipv6s", ipv6s); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving all_fixed_ipv6s to state for OpenTelekomCloud port (%s): %s", d.Id(), err)
+	}
 
 	// Convert AllowedAddressPairs to list of map
 	var pairs []map[string]interface{}
@@ -427,11 +443,24 @@ func resourcePortAdminStateUpV2(d *schema.ResourceData) *bool {
 func allowedAddressPairsHash(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
-	buf.WriteString(fmt.Sprintf("%s", m["ip_address"].(string)))
+	buf.WriteString(normalizeIPAddress(m["ip_address"].(string)))
 
 	return hashcode.String(buf.String())
 }
 
+// normalizeIPAddress returns canonical representation of IP address or CIDR,
+// so differently written IPv6 addresses are considered the same
+func normalizeIPAddress(address string) string {
+	if ip := net.ParseIP(address); ip != nil {
+		return ip.String()
+	}
+	if ip, ipNet, err := net.ParseCIDR(address); err == nil {
+		ones, _ := ipNet.Mask.Size()
+		return fmt.Sprintf("%s/%d", ip, ones)
+	}
+	return address
+}
+
 func waitForNetworkPortActive(networkingClient *golangsdk.ServiceClient, portId string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		p, err := ports.Get(networkingClient, portId).Extract()
@@ -3,12 +3,14 @@ package vpc
 import (
 	"fmt"
 	"log"
+	"net"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/opentelekomcloud/gophertelekomcloud"
 
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/security/rules"
@@ -30,6 +32,8 @@ func ResourceNetworkingSecGroupRuleV2() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: checkSecGroupRuleRemoteIPPrefix,
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:     schema.TypeString,
@@ -52,6 +56,9 @@ func ResourceNetworkingSecGroupRuleV2() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"IPv4", "IPv6",
+				}, false),
 			},
 			"port_range_min": {
 				Type:     schema.TypeInt,
@@ -77,6 +84,13 @@ func ResourceNetworkingSecGroupRuleV2() *schema.Resource {
 				ForceNew: true,
 				Computed: true,
 			},
+			"remote_address_group_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Computed:      true,
+				ConflictsWith: []string{"remote_ip_prefix", "remote_group_id"},
+			},
 			"remote_ip_prefix": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -85,6 +99,7 @@ func ResourceNetworkingSecGroupRuleV2() *schema.Resource {
 				StateFunc: func(v interface{}) string {
 					return strings.ToLower(v.(string))
 				},
+				DiffSuppressFunc: common.SuppressEquivalentCIDRDiffs,
 			},
 			"security_group_id": {
 				Type:     schema.TypeString,
@@ -119,14 +134,17 @@ func resourceNetworkingSecGroupRuleV2Create(d *schema.ResourceData, meta interfa
 		}
 	}
 
-	opts := rules.CreateOpts{
-		Description:    d.Get("description").(string),
-		SecGroupID:     d.Get("security_group_id").(string),
-		PortRangeMin:   d.Get("port_range_min").(int),
-		PortRangeMax:   d.Get("port_range_max").(int),
-		RemoteGroupID:  d.Get("remote_group_id").(string),
-		RemoteIPPrefix: d.Get("remote_ip_prefix").(string),
-		TenantID:       d.Get("tenant_id").(string),
+	opts := SecGroupRuleCreateOpts{
+		CreateOpts: rules.CreateOpts{
+			Description:    d.Get("description").(string),
+			SecGroupID:     d.Get("security_group_id").(string),
+			PortRangeMin:   d.Get("port_range_min").(int),
+			PortRangeMax:   d.Get("port_range_max").(int),
+			RemoteGroupID:  d.Get("remote_group_id").(string),
+			RemoteIPPrefix: d.Get("remote_ip_prefix").(string),
+			TenantID:       d.Get("tenant_id").(string),
+		},
+		RemoteAddressGroupID: d.Get("remote_address_group_id").(string),
 	}
 
 	if v, ok := d.GetOk("direction"); ok {
@@ -146,7 +164,7 @@ func resourceNetworkingSecGroupRuleV2Create(d *schema.ResourceData, meta interfa
 
 	log.Printf("[DEBUG] Create OpenTelekomCloud Neutron security group: %#v", opts)
 
-	security_group_rule, err := rules.Create(networkingClient, opts).Extract()
+	security_group_rule, err := ExtractSecGroupRule(rules.Create(networkingClient, opts).Result)
 	if err != nil {
 		return err
 	}
@@ -167,7 +185,7 @@ func resourceNetworkingSecGroupRuleV2Read(d *schema.ResourceData, meta interface
 		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
 	}
 
-	security_group_rule, err := rules.Get(networkingClient, d.Id()).Extract()
+	security_group_rule, err := ExtractSecGroupRule(rules.Get(networkingClient, d.Id()).Result)
 
 	if err != nil {
 		return common.CheckDeleted(d, err, "OpenTelekomCloud Security Group Rule")
@@ -181,6 +199,7 @@ func resourceNetworkingSecGroupRuleV2Read(d *schema.ResourceData, meta interface
 	d.Set("port_range_max", security_group_rule.PortRangeMax)
 	d.Set("remote_group_id", security_group_rule.RemoteGroupID)
 	d.Set("remote_ip_prefix", security_group_rule.RemoteIPPrefix)
+	d.Set("remote_address_group_id", security_group_rule.RemoteAddressGroupID)
 	d.Set("security_group_id", security_group_rule.SecGroupID)
 	d.Set("tenant_id", security_group_rule.TenantID)
 	d.Set("region", config.GetRegion(d))
@@ -215,6 +234,29 @@ func resourceNetworkingSecGroupRuleV2Delete(d *schema.ResourceData, meta interfa
 	return err
 }
 
+func checkSecGroupRuleRemoteIPPrefix(d *schema.ResourceDiff, _ interface{}) error {
+	return validateSecGroupRuleRemoteIPPrefix(d.Get("ethertype").(string), d.Get("remote_ip_prefix").(string))
+}
+
+// validateSecGroupRuleRemoteIPPrefix checks if the remote IP prefix belongs to the IP version of the rule
+func validateSecGroupRuleRemoteIPPrefix(etherType, prefix string) error {
+	if prefix == "" {
+		return nil
+	}
+	ip, _, err := net.ParseCIDR(prefix)
+	if err != nil {
+		ip = net.ParseIP(prefix)
+	}
+	if ip == nil {
+		return fmt.Errorf("remote_ip_prefix %q is not valid IP address or CIDR", prefix)
+	}
+	isIPv4 := ip.To4() != nil
+	if etherType == "IPv4" && !isIPv4 || etherType == "IPv6" && isIPv4 {
+		return fmt.Errorf("remote_ip_prefix %q doesn't match the ethertype %s", prefix, etherType)
+	}
+	return nil
+}
+
 func resourceNetworkingSecGroupRuleV2DetermineDirection(v string) rules.RuleDirection {
 	var direction rules.RuleDirection
 	switch v {
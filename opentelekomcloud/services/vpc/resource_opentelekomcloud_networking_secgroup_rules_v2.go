@@ -0,0 +1,417 @@
+package vpc
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/security/groups"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/security/rules"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const secGroupRuleRetries = 5
+
+func ResourceNetworkingSecGroupRulesV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkingSecGroupRulesV2Create,
+		Read:   resourceNetworkingSecGroupRulesV2Read,
+		Update: resourceNetworkingSecGroupRulesV2Update,
+		Delete: resourceNetworkingSecGroupRulesV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: checkSecGroupRules,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"security_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"rule": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      resourceSecGroupRuleHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"direction": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"ingress", "egress",
+							}, false),
+						},
+						"ethertype": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"IPv4", "IPv6",
+							}, false),
+						},
+						"protocol": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							StateFunc: normalizeSecGroupRuleProtocol,
+						},
+						"port_range_min": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"port_range_max": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"remote_ip_prefix": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							DiffSuppressFunc: common.SuppressEquivalentCIDRDiffs,
+						},
+						"remote_group_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"remote_address_group_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 255),
+						},
+					},
+				},
+			},
+			"parallel_requests": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validation.IntBetween(1, 20),
+			},
+		},
+	}
+}
+
+func normalizeSecGroupRuleProtocol(v interface{}) string {
+	return strings.ToLower(v.(string))
+}
+
+// secGroupRuleMatchKey builds the key identifying the rule by the attributes checked by the API
+// for duplicates, the description is not one of them
+func secGroupRuleMatchKey(m map[string]interface{}) string {
+	return fmt.Sprintf("%s-%s-%s-%d-%d-%s-%s-%s",
+		m["direction"].(string),
+		m["ethertype"].(string),
+		normalizeSecGroupRuleProtocol(m["protocol"]),
+		m["port_range_min"].(int),
+		m["port_range_max"].(int),
+		normalizeIPAddress(m["remote_ip_prefix"].(string)),
+		m["remote_group_id"].(string),
+		m["remote_address_group_id"].(string),
+	)
+}
+
+// secGroupRuleKey builds the key identifying the rule by all of its attributes,
+// the rules are immutable, so the rules with different keys are different rules
+func secGroupRuleKey(m map[string]interface{}) string {
+	return fmt.Sprintf("%s-%s", secGroupRuleMatchKey(m), m["description"].(string))
+}
+
+func resourceSecGroupRuleHash(v interface{}) int {
+	return hashcode.String(secGroupRuleKey(v.(map[string]interface{})))
+}
+
+func flattenSecGroupRule(rule SecGroupRule) map[string]interface{} {
+	return map[string]interface{}{
+		"direction":               rule.Direction,
+		"ethertype":               rule.EtherType,
+		"protocol":                normalizeSecGroupRuleProtocol(rule.Protocol),
+		"port_range_min":          rule.PortRangeMin,
+		"port_range_max":          rule.PortRangeMax,
+		"remote_ip_prefix":        rule.RemoteIPPrefix,
+		"remote_group_id":         rule.RemoteGroupID,
+		"remote_address_group_id": rule.RemoteAddressGroupID,
+		"description":             rule.Description,
+	}
+}
+
+func expandSecGroupRule(secGroupID string, m map[string]interface{}) SecGroupRuleCreateOpts {
+	return SecGroupRuleCreateOpts{
+		CreateOpts: rules.CreateOpts{
+			SecGroupID:     secGroupID,
+			Direction:      resourceNetworkingSecGroupRuleV2DetermineDirection(m["direction"].(string)),
+			EtherType:      resourceNetworkingSecGroupRuleV2DetermineEtherType(m["ethertype"].(string)),
+			Protocol:       resourceNetworkingSecGroupRuleV2DetermineProtocol(m["protocol"].(string)),
+			PortRangeMin:   m["port_range_min"].(int),
+			PortRangeMax:   m["port_range_max"].(int),
+			RemoteIPPrefix: m["remote_ip_prefix"].(string),
+			RemoteGroupID:  m["remote_group_id"].(string),
+			Description:    m["description"].(string),
+		},
+		RemoteAddressGroupID: m["remote_address_group_id"].(string),
+	}
+}
+
+func validateSecGroupRule(m map[string]interface{}) error {
+	remotes := 0
+	for _, key := range []string{"remote_ip_prefix", "remote_group_id", "remote_address_group_id"} {
+		if m[key].(string) != "" {
+			remotes++
+		}
+	}
+	if remotes > 1 {
+		return fmt.Errorf("only one of remote_ip_prefix, remote_group_id and remote_address_group_id can be set in the rule")
+	}
+	if m["protocol"].(string) == "" && (m["port_range_min"].(int) != 0 || m["port_range_max"].(int) != 0) {
+		return fmt.Errorf("a protocol must be specified when using port_range_min and port_range_max")
+	}
+	return validateSecGroupRuleRemoteIPPrefix(m["ethertype"].(string), m["remote_ip_prefix"].(string))
+}
+
+func checkSecGroupRules(d *schema.ResourceDiff, _ interface{}) error {
+	mErr := &multierror.Error{}
+	for _, raw := range d.Get("rule").(*schema.Set).List() {
+		if err := validateSecGroupRule(raw.(map[string]interface{})); err != nil {
+			mErr = multierror.Append(mErr, err)
+		}
+	}
+	return mErr.ErrorOrNil()
+}
+
+// withSecGroupRuleRetries retries the request if it's rejected because of the rate limit
+func withSecGroupRuleRetries(fn func() error) error {
+	var err error
+	for attempt := 1; attempt <= secGroupRuleRetries; attempt++ {
+		if err = fn(); err == nil {
+			return nil
+		}
+		if _, ok := err.(golangsdk.ErrDefault429); !ok {
+			return err
+		}
+		log.Printf("[DEBUG] Security group rule request is throttled, attempt %d: %s", attempt, err)
+		time.Sleep(time.Duration(attempt) * time.Second)
+	}
+	return err
+}
+
+// runParallel runs the `fn` for every task using at most `parallel` goroutines and returns all errors
+func runParallel(parallel int, tasks []interface{}, fn func(interface{}) error) error {
+	queue := make(chan interface{})
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	mErr := &multierror.Error{}
+
+	for i := 0; i < parallel; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for task := range queue {
+				if err := fn(task); err != nil {
+					mu.Lock()
+					mErr = multierror.Append(mErr, err)
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+
+	for _, task := range tasks {
+		queue <- task
+	}
+	close(queue)
+	wg.Wait()
+
+	return mErr.ErrorOrNil()
+}
+
+func deleteSecGroupRules(client *golangsdk.ServiceClient, parallel int, ruleIDs []interface{}) error {
+	return runParallel(parallel, ruleIDs, func(task interface{}) error {
+		id := task.(string)
+		log.Printf("[DEBUG] Deleting security group rule %s", id)
+		err := withSecGroupRuleRetries(func() error {
+			return rules.Delete(client, id).ExtractErr()
+		})
+		if _, ok := err.(golangsdk.ErrDefault404); ok {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("error deleting security group rule %s: %s", id, err)
+		}
+		return nil
+	})
+}
+
+func createSecGroupRules(client *golangsdk.ServiceClient, parallel int, opts []interface{}) error {
+	return runParallel(parallel, opts, func(task interface{}) error {
+		createOpts := task.(SecGroupRuleCreateOpts)
+		log.Printf("[DEBUG] Creating security group rule: %#v", createOpts)
+		err := withSecGroupRuleRetries(func() error {
+			return rules.Create(client, createOpts).Err
+		})
+		if err != nil {
+			return fmt.Errorf("error creating security group rule %s %s %s: %s",
+				createOpts.Direction, createOpts.EtherType, createOpts.Protocol, err)
+		}
+		return nil
+	})
+}
+
+// applySecGroupRules computes the difference between existing and configured rules of the group,
+// creates the new rules and deletes the rules missing in the configuration afterwards,
+// so the group is never left without the rules during the update.
+// The stale rules differing from the new ones only by the description are deleted first,
+// as the API rejects the duplicate rules.
+func applySecGroupRules(d *schema.ResourceData, client *golangsdk.ServiceClient) error {
+	secGroupID := d.Id()
+	parallel := d.Get("parallel_requests").(int)
+
+	desired := make(map[string]map[string]interface{})
+	for _, raw := range d.Get("rule").(*schema.Set).List() {
+		m := raw.(map[string]interface{})
+		desired[secGroupRuleKey(m)] = m
+	}
+
+	existing, err := ListSecGroupRules(client, secGroupID)
+	if err != nil {
+		return fmt.Errorf("error listing security group rules: %s", err)
+	}
+
+	stale := make(map[string][]interface{})
+	found := make(map[string]bool)
+	for _, rule := range existing {
+		m := flattenSecGroupRule(rule)
+		key := secGroupRuleKey(m)
+		if _, ok := desired[key]; ok && !found[key] {
+			found[key] = true
+			continue
+		}
+		matchKey := secGroupRuleMatchKey(m)
+		stale[matchKey] = append(stale[matchKey], rule.ID)
+	}
+
+	var toCreate, toDeleteFirst []interface{}
+	for key, m := range desired {
+		if found[key] {
+			continue
+		}
+		toCreate = append(toCreate, expandSecGroupRule(secGroupID, m))
+		matchKey := secGroupRuleMatchKey(m)
+		toDeleteFirst = append(toDeleteFirst, stale[matchKey]...)
+		delete(stale, matchKey)
+	}
+	var toDelete []interface{}
+	for _, ids := range stale {
+		toDelete = append(toDelete, ids...)
+	}
+
+	log.Printf("[DEBUG] Security group %s: %d rules to create, %d rules to delete",
+		secGroupID, len(toCreate), len(toDeleteFirst)+len(toDelete))
+	if err := deleteSecGroupRules(client, parallel, toDeleteFirst); err != nil {
+		return err
+	}
+	if err := createSecGroupRules(client, parallel, toCreate); err != nil {
+		return err
+	}
+	return deleteSecGroupRules(client, parallel, toDelete)
+}
+
+func resourceNetworkingSecGroupRulesV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud NetworkingV2 client: %s", err)
+	}
+
+	d.SetId(d.Get("security_group_id").(string))
+
+	if err := applySecGroupRules(d, client); err != nil {
+		return err
+	}
+
+	return resourceNetworkingSecGroupRulesV2Read(d, meta)
+}
+
+func resourceNetworkingSecGroupRulesV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud NetworkingV2 client: %s", err)
+	}
+
+	if _, err := groups.Get(client, d.Id()).Extract(); err != nil {
+		return common.CheckDeleted(d, err, "security group")
+	}
+
+	existing, err := ListSecGroupRules(client, d.Id())
+	if err != nil {
+		return fmt.Errorf("error listing security group rules: %s", err)
+	}
+	// all rules of the group are set, so the rules created outside of the resource are shown as drift
+	secGroupRules := make([]interface{}, len(existing))
+	for i, rule := range existing {
+		secGroupRules[i] = flattenSecGroupRule(rule)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("security_group_id", d.Id()),
+		d.Set("rule", schema.NewSet(resourceSecGroupRuleHash, secGroupRules)),
+	)
+	return mErr.ErrorOrNil()
+}
+
+func resourceNetworkingSecGroupRulesV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud NetworkingV2 client: %s", err)
+	}
+
+	if d.HasChange("rule") {
+		if err := applySecGroupRules(d, client); err != nil {
+			return err
+		}
+	}
+
+	return resourceNetworkingSecGroupRulesV2Read(d, meta)
+}
+
+func resourceNetworkingSecGroupRulesV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud NetworkingV2 client: %s", err)
+	}
+
+	existing, err := ListSecGroupRules(client, d.Id())
+	if err != nil {
+		return common.CheckDeleted(d, err, "security group rules")
+	}
+	ruleIDs := make([]interface{}, len(existing))
+	for i, rule := range existing {
+		ruleIDs[i] = rule.ID
+	}
+	if err := deleteSecGroupRules(client, d.Get("parallel_requests").(int), ruleIDs); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
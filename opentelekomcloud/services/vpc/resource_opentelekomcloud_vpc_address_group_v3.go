@@ -0,0 +1,290 @@
+package vpc
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceVpcAddressGroupV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVpcAddressGroupV3Create,
+		Read:   resourceVpcAddressGroupV3Read,
+		Update: resourceVpcAddressGroupV3Update,
+		Delete: resourceVpcAddressGroupV3Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: checkAddressGroupEntriesV3,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"ip_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      4,
+				ValidateFunc: validation.IntInSlice([]int{4, 6}),
+			},
+			"max_capacity": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"address": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      resourceAddressGroupEntryHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     validateAddressGroupEntry,
+							DiffSuppressFunc: suppressEquivalentAddressGroupEntryDiffs,
+						},
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 255),
+						},
+					},
+				},
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// parseAddressGroupEntry parses the IP address, CIDR or IP address range (e.g. `10.0.0.1-10.0.0.10`)
+// and returns the normalized entry and its IP version
+func parseAddressGroupEntry(entry string) (string, int, error) {
+	parts := strings.Split(entry, "-")
+	if len(parts) > 2 {
+		return "", 0, fmt.Errorf("%q is not a valid IP address, CIDR or IP address range", entry)
+	}
+	version := 0
+	for i, part := range parts {
+		ip := net.ParseIP(part)
+		if ip == nil && len(parts) == 1 {
+			var ipNet *net.IPNet
+			var err error
+			ip, ipNet, err = net.ParseCIDR(part)
+			if err != nil {
+				return "", 0, fmt.Errorf("%q is not a valid IP address, CIDR or IP address range", entry)
+			}
+			part = ipNet.String()
+		} else if ip == nil {
+			return "", 0, fmt.Errorf("%q is not a valid IP address range", entry)
+		} else {
+			part = ip.String()
+		}
+
+		partVersion := 6
+		if ip.To4() != nil {
+			partVersion = 4
+		}
+		if version != 0 && version != partVersion {
+			return "", 0, fmt.Errorf("IP address range %q mixes IPv4 and IPv6 addresses", entry)
+		}
+		version = partVersion
+		parts[i] = part
+	}
+	return strings.Join(parts, "-"), version, nil
+}
+
+func validateAddressGroupEntry(v interface{}, k string) (ws []string, errors []error) {
+	if _, _, err := parseAddressGroupEntry(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q: %s", k, err))
+	}
+	return
+}
+
+func normalizeAddressGroupEntry(entry string) string {
+	normalized, _, err := parseAddressGroupEntry(entry)
+	if err != nil {
+		return entry
+	}
+	return normalized
+}
+
+func suppressEquivalentAddressGroupEntryDiffs(_, old, new string, _ *schema.ResourceData) bool {
+	return normalizeAddressGroupEntry(old) == normalizeAddressGroupEntry(new)
+}
+
+func resourceAddressGroupEntryHash(v interface{}) int {
+	m := v.(map[string]interface{})
+	return hashcode.String(fmt.Sprintf("%s-%s", normalizeAddressGroupEntry(m["ip"].(string)), m["description"].(string)))
+}
+
+// checkAddressGroupEntriesV3 checks the addresses match the IP version of the group
+func checkAddressGroupEntriesV3(d *schema.ResourceDiff, _ interface{}) error {
+	ipVersion := d.Get("ip_version").(int)
+	mErr := &multierror.Error{}
+	for _, raw := range d.Get("address").(*schema.Set).List() {
+		ip := raw.(map[string]interface{})["ip"].(string)
+		if ip == "" {
+			continue
+		}
+		_, version, err := parseAddressGroupEntry(ip)
+		if err != nil {
+			mErr = multierror.Append(mErr, err)
+			continue
+		}
+		if version != ipVersion {
+			mErr = multierror.Append(mErr, fmt.Errorf("address %q doesn't match IP version %d of the address group", ip, ipVersion))
+		}
+	}
+	return mErr.ErrorOrNil()
+}
+
+// resourceAddressGroupEntriesV3 builds the entries of the group with the normalized addresses
+func resourceAddressGroupEntriesV3(d *schema.ResourceData) []AddressGroupIPEntry {
+	addresses := d.Get("address").(*schema.Set).List()
+	entries := make([]AddressGroupIPEntry, len(addresses))
+	for i, raw := range addresses {
+		address := raw.(map[string]interface{})
+		entries[i] = AddressGroupIPEntry{
+			IP:      normalizeAddressGroupEntry(address["ip"].(string)),
+			Remarks: address["description"].(string),
+		}
+	}
+	return entries
+}
+
+func resourceVpcAddressGroupV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud NetworkingV3 client: %s", err)
+	}
+
+	createOpts := AddressGroupCreateOpts{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		IPVersion:   d.Get("ip_version").(int),
+		MaxCapacity: d.Get("max_capacity").(int),
+		IPExtraSet:  resourceAddressGroupEntriesV3(d),
+	}
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+
+	addressGroup, err := createAddressGroup(client, createOpts)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud VPC address group: %s", err)
+	}
+	d.SetId(addressGroup.ID)
+
+	return resourceVpcAddressGroupV3Read(d, meta)
+}
+
+func resourceVpcAddressGroupV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud NetworkingV3 client: %s", err)
+	}
+
+	addressGroup, err := getAddressGroup(client, d.Id())
+	if err != nil {
+		return common.CheckDeleted(d, err, "VPC address group")
+	}
+	log.Printf("[DEBUG] Retrieved VPC address group %s: %#v", d.Id(), addressGroup)
+
+	addresses := make([]interface{}, len(addressGroup.IPExtraSet))
+	for i, entry := range addressGroup.IPExtraSet {
+		addresses[i] = map[string]interface{}{
+			"ip":          entry.IP,
+			"description": entry.Remarks,
+		}
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", addressGroup.Name),
+		d.Set("description", addressGroup.Description),
+		d.Set("ip_version", addressGroup.IPVersion),
+		d.Set("max_capacity", addressGroup.MaxCapacity),
+		d.Set("address", schema.NewSet(resourceAddressGroupEntryHash, addresses)),
+		d.Set("created_at", addressGroup.CreatedAt),
+		d.Set("updated_at", addressGroup.UpdatedAt),
+	)
+	return mErr.ErrorOrNil()
+}
+
+func resourceVpcAddressGroupV3Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud NetworkingV3 client: %s", err)
+	}
+
+	updateOpts := AddressGroupUpdateOpts{
+		Name: d.Get("name").(string),
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+	if d.HasChange("max_capacity") {
+		updateOpts.MaxCapacity = d.Get("max_capacity").(int)
+	}
+	if d.HasChange("address") {
+		entries := resourceAddressGroupEntriesV3(d)
+		updateOpts.IPExtraSet = &entries
+	}
+	log.Printf("[DEBUG] Update Options: %#v", updateOpts)
+
+	if err := updateAddressGroup(client, d.Id(), updateOpts); err != nil {
+		return fmt.Errorf("error updating OpenTelekomCloud VPC address group: %s", err)
+	}
+
+	return resourceVpcAddressGroupV3Read(d, meta)
+}
+
+func resourceVpcAddressGroupV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud NetworkingV3 client: %s", err)
+	}
+
+	if err := deleteAddressGroup(client, d.Id()); err != nil {
+		return common.CheckDeleted(d, err, "VPC address group")
+	}
+
+	d.SetId("")
+	return nil
+}
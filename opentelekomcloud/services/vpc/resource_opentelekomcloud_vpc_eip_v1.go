@@ -14,6 +14,7 @@ import (
 
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/eps"
 )
 
 func ResourceVpcEIPV1() *schema.Resource {
@@ -99,6 +100,11 @@ func ResourceVpcEIPV1() *schema.Resource {
 				Optional: true,
 			},
 			"tags": common.TagsSchema(),
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -111,11 +117,12 @@ func resourceVpcEIPV1Create(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	createOpts := EIPCreateOpts{
-		eips.ApplyOpts{
+		ApplyOpts: eips.ApplyOpts{
 			IP:        resourcePublicIP(d),
 			Bandwidth: resourceBandWidth(d),
 		},
-		common.MapValueSpecs(d),
+		ValueSpecs:          common.MapValueSpecs(d),
+		EnterpriseProjectID: d.Get("enterprise_project_id").(string),
 	}
 
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
@@ -153,10 +160,15 @@ func resourceVpcEIPV1Read(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error creating NetworkingV1 client: %s", err)
 	}
 
-	eip, err := eips.Get(client, d.Id()).Extract()
+	result := eips.Get(client, d.Id())
+	eip, err := result.Extract()
 	if err != nil {
 		return common.CheckDeleted(d, err, "eIP")
 	}
+	epsID, err := eps.ExtractEnterpriseProjectID(result, "publicip")
+	if err != nil {
+		return fmt.Errorf("error extracting enterprise project ID of EIP: %s", err)
+	}
 	bandWidth, err := bandwidths.Get(client, eip.BandwidthID).Extract()
 	if err != nil {
 		return fmt.Errorf("error fetching bandwidth: %s", err)
@@ -189,6 +201,9 @@ func resourceVpcEIPV1Read(d *schema.ResourceData, meta interface{}) error {
 	if err := d.Set("region", config.GetRegion(d)); err != nil {
 		return err
 	}
+	if err := d.Set("enterprise_project_id", epsID); err != nil {
+		return err
+	}
 
 	if err := readNetworkingTags(d, config, "publicips"); err != nil {
 		return err
@@ -254,6 +269,14 @@ func resourceVpcEIPV1Update(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	migrateOpts := eps.MigrateResourceOpts{
+		ResourceType: "eip",
+		ResourceID:   d.Id(),
+	}
+	if err := eps.MigrateResource(d, config, migrateOpts); err != nil {
+		return err
+	}
+
 	return resourceVpcEIPV1Read(d, meta)
 }
 
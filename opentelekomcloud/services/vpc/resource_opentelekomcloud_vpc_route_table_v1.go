@@ -0,0 +1,269 @@
+package vpc
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func ResourceVPCRouteTableV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVpcRouteTableV1Create,
+		Read:   resourceVpcRouteTableV1Read,
+		Update: resourceVpcRouteTableV1Update,
+		Delete: resourceVpcRouteTableV1Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: common.ValidateName,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"subnets": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"route": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 200,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: common.ValidateCIDR,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"ecs", "vip", "nat", "vpn", "peering",
+							}, false),
+						},
+						"nexthop": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 255),
+						},
+					},
+				},
+			},
+			"default": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceRouteTableRoutesV1(routes []interface{}) []RouteTableRoute {
+	result := make([]RouteTableRoute, len(routes))
+	for i, raw := range routes {
+		route := raw.(map[string]interface{})
+		result[i] = RouteTableRoute{
+			Destination: route["destination"].(string),
+			Type:        route["type"].(string),
+			NextHop:     route["nexthop"].(string),
+			Description: route["description"].(string),
+		}
+	}
+	return result
+}
+
+// resourceRouteTableRoutesChangeV1 builds `add`, `mod` and `del` route lists, routes are identified by the destination
+func resourceRouteTableRoutesChangeV1(d *schema.ResourceData) map[string][]RouteTableRoute {
+	oldRaw, newRaw := d.GetChange("route")
+	oldRoutes := make(map[string]RouteTableRoute)
+	for _, route := range resourceRouteTableRoutesV1(oldRaw.(*schema.Set).List()) {
+		oldRoutes[route.Destination] = route
+	}
+	newRoutes := make(map[string]RouteTableRoute)
+	for _, route := range resourceRouteTableRoutesV1(newRaw.(*schema.Set).List()) {
+		newRoutes[route.Destination] = route
+	}
+
+	changes := make(map[string][]RouteTableRoute)
+	for destination, route := range newRoutes {
+		oldRoute, ok := oldRoutes[destination]
+		switch {
+		case !ok:
+			changes["add"] = append(changes["add"], route)
+		case oldRoute != route:
+			changes["mod"] = append(changes["mod"], route)
+		}
+	}
+	for destination, route := range oldRoutes {
+		if _, ok := newRoutes[destination]; !ok {
+			changes["del"] = append(changes["del"], route)
+		}
+	}
+	return changes
+}
+
+func resourceVpcRouteTableV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud NetworkingV1 client: %s", err)
+	}
+
+	createOpts := RouteTableCreateOpts{
+		VpcID:       d.Get("vpc_id").(string),
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Routes:      resourceRouteTableRoutesV1(d.Get("route").(*schema.Set).List()),
+	}
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+
+	routeTable, err := createRouteTable(client, createOpts)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud VPC route table: %s", err)
+	}
+	d.SetId(routeTable.ID)
+
+	subnets := common.ExpandToStringSlice(d.Get("subnets").(*schema.Set).List())
+	if err := updateRouteTableSubnets(client, d.Id(), subnets, nil); err != nil {
+		return fmt.Errorf("error associating subnets with VPC route table: %s", err)
+	}
+
+	return resourceVpcRouteTableV1Read(d, meta)
+}
+
+func resourceVpcRouteTableV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud NetworkingV1 client: %s", err)
+	}
+
+	routeTable, err := getRouteTable(client, d.Id())
+	if err != nil {
+		return common.CheckDeleted(d, err, "VPC route table")
+	}
+	log.Printf("[DEBUG] Retrieved VPC route table %s: %#v", d.Id(), routeTable)
+
+	subnets := make([]string, len(routeTable.Subnets))
+	for i, subnet := range routeTable.Subnets {
+		subnets[i] = subnet.ID
+	}
+
+	var routes []map[string]interface{}
+	for _, route := range routeTable.Routes {
+		// `local` routes are created by the system and can't be managed
+		if route.Type == "local" {
+			continue
+		}
+		routes = append(routes, map[string]interface{}{
+			"destination": route.Destination,
+			"type":        route.Type,
+			"nexthop":     route.NextHop,
+			"description": route.Description,
+		})
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("vpc_id", routeTable.VpcID),
+		d.Set("name", routeTable.Name),
+		d.Set("description", routeTable.Description),
+		d.Set("default", routeTable.Default),
+		d.Set("subnets", subnets),
+		d.Set("route", routes),
+	)
+	return mErr.ErrorOrNil()
+}
+
+func resourceVpcRouteTableV1Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud NetworkingV1 client: %s", err)
+	}
+
+	if d.HasChanges("name", "description", "route") {
+		updateOpts := RouteTableUpdateOpts{
+			Name: d.Get("name").(string),
+		}
+		if d.HasChange("description") {
+			description := d.Get("description").(string)
+			updateOpts.Description = &description
+		}
+		if d.HasChange("route") {
+			updateOpts.Routes = resourceRouteTableRoutesChangeV1(d)
+		}
+		log.Printf("[DEBUG] Update Options: %#v", updateOpts)
+
+		if err := updateRouteTable(client, d.Id(), updateOpts); err != nil {
+			return fmt.Errorf("error updating OpenTelekomCloud VPC route table: %s", err)
+		}
+	}
+
+	if d.HasChange("subnets") {
+		oldRaw, newRaw := d.GetChange("subnets")
+		oldSet := oldRaw.(*schema.Set)
+		newSet := newRaw.(*schema.Set)
+		associate := common.ExpandToStringSlice(newSet.Difference(oldSet).List())
+		disassociate := common.ExpandToStringSlice(oldSet.Difference(newSet).List())
+		if err := updateRouteTableSubnets(client, d.Id(), associate, disassociate); err != nil {
+			return fmt.Errorf("error updating subnets of VPC route table: %s", err)
+		}
+	}
+
+	return resourceVpcRouteTableV1Read(d, meta)
+}
+
+func resourceVpcRouteTableV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud NetworkingV1 client: %s", err)
+	}
+
+	if d.Get("default").(bool) {
+		return fmt.Errorf("default VPC route table can't be deleted")
+	}
+
+	// subnets are returned to the default route table of the VPC before the deletion
+	subnets := common.ExpandToStringSlice(d.Get("subnets").(*schema.Set).List())
+	if err := updateRouteTableSubnets(client, d.Id(), nil, subnets); err != nil {
+		return common.CheckDeleted(d, err, "VPC route table")
+	}
+
+	if err := deleteRouteTable(client, d.Id()); err != nil {
+		return common.CheckDeleted(d, err, "VPC route table")
+	}
+
+	d.SetId("")
+	return nil
+}
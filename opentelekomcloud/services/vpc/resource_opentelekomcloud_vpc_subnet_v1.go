@@ -3,10 +3,14 @@ package vpc
 import (
 	"fmt"
 	"log"
+	"regexp"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/opentelekomcloud/gophertelekomcloud"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/subnets"
@@ -24,6 +28,67 @@ func resourceSubnetDNSListV1(d *schema.ResourceData) []string {
 	return dnsn
 }
 
+// subnetExtraDhcpOpts maps the schema arguments to the names of the extra DHCP options
+var subnetExtraDhcpOpts = []struct {
+	argument string
+	option   string
+}{
+	{"ntp_addresses", "ntp"},
+	{"dhcp_lease_time", "addresstime"},
+	{"dhcp_domain_name", "domainname"},
+}
+
+// resourceSubnetExtraDhcpOptsV1 builds the list of extra DHCP options, options changed to empty
+// value are sent with empty value to be removed from the subnet
+func resourceSubnetExtraDhcpOptsV1(d *schema.ResourceData) []subnets.ExtraDhcpOpt {
+	var opts []subnets.ExtraDhcpOpt
+	for _, extra := range subnetExtraDhcpOpts {
+		value := d.Get(extra.argument).(string)
+		if value == "" && (d.IsNewResource() || !d.HasChange(extra.argument)) {
+			continue
+		}
+		opts = append(opts, subnets.ExtraDhcpOpt{
+			OptName:  extra.option,
+			OptValue: value,
+		})
+	}
+	return opts
+}
+
+func setVpcSubnetExtraDhcpOpts(d *schema.ResourceData, opts []subnets.ExtraDhcp) error {
+	mErr := &multierror.Error{}
+	for _, extra := range subnetExtraDhcpOpts {
+		value := ""
+		for _, opt := range opts {
+			if opt.OptName == extra.option {
+				value = opt.OptValue
+				break
+			}
+		}
+		mErr = multierror.Append(mErr, d.Set(extra.argument, value))
+	}
+	return mErr.ErrorOrNil()
+}
+
+func setVpcSubnetIPv6(d *schema.ResourceData, ipv6 *VpcSubnetIPv6) error {
+	mErr := multierror.Append(nil,
+		d.Set("ipv6_enable", ipv6.IPv6Enable),
+		d.Set("ipv6_cidr", ipv6.IPv6CIDR),
+		d.Set("ipv6_gateway", ipv6.IPv6Gateway),
+		d.Set("ipv6_subnet_id", ipv6.IPv6SubnetID),
+	)
+	return mErr.ErrorOrNil()
+}
+
+func subnetExtraDhcpOptsChanged(d *schema.ResourceData) bool {
+	for _, extra := range subnetExtraDhcpOpts {
+		if d.HasChange(extra.argument) {
+			return true
+		}
+	}
+	return false
+}
+
 func ResourceVpcSubnetV1() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceVpcSubnetV1Create,
@@ -39,6 +104,8 @@ func ResourceVpcSubnetV1() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: customdiff.ForceNewIfChange("ipv6_enable", isIPv6Disabled),
+
 		Schema: map[string]*schema.Schema{ // request and response parameters
 			"region": {
 				Type:     schema.TypeString,
@@ -109,6 +176,37 @@ func ResourceVpcSubnetV1() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"dhcp_lease_time": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^(-1|[1-9]\d{0,4}h)$`),
+					"lease time must be `-1` (unlimited) or number of hours from 1 to 30000, e.g. `24h`",
+				),
+			},
+			"dhcp_domain_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 63),
+			},
+			"ipv6_enable": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"ipv6_cidr": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ipv6_gateway": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ipv6_subnet_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"subnet_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -125,26 +223,20 @@ func resourceVpcSubnetV1Create(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
 	}
 
-	createOpts := subnets.CreateOpts{
-		Name:             d.Get("name").(string),
-		CIDR:             d.Get("cidr").(string),
-		AvailabilityZone: d.Get("availability_zone").(string),
-		GatewayIP:        d.Get("gateway_ip").(string),
-		EnableDHCP:       d.Get("dhcp_enable").(bool),
-		VPC_ID:           d.Get("vpc_id").(string),
-		PRIMARY_DNS:      d.Get("primary_dns").(string),
-		SECONDARY_DNS:    d.Get("secondary_dns").(string),
-		DnsList:          resourceSubnetDNSListV1(d),
-	}
-
-	if common.HasFilledOpt(d, "ntp_addresses") {
-		var extraDhcpRequests []subnets.ExtraDhcpOpt
-		extraDhcpReq := subnets.ExtraDhcpOpt{
-			OptName:  "ntp",
-			OptValue: d.Get("ntp_addresses").(string),
-		}
-		extraDhcpRequests = append(extraDhcpRequests, extraDhcpReq)
-		createOpts.ExtraDhcpOpts = extraDhcpRequests
+	createOpts := VpcSubnetCreateOpts{
+		CreateOpts: subnets.CreateOpts{
+			Name:             d.Get("name").(string),
+			CIDR:             d.Get("cidr").(string),
+			AvailabilityZone: d.Get("availability_zone").(string),
+			GatewayIP:        d.Get("gateway_ip").(string),
+			EnableDHCP:       d.Get("dhcp_enable").(bool),
+			VPC_ID:           d.Get("vpc_id").(string),
+			PRIMARY_DNS:      d.Get("primary_dns").(string),
+			SECONDARY_DNS:    d.Get("secondary_dns").(string),
+			DnsList:          resourceSubnetDNSListV1(d),
+			ExtraDhcpOpts:    resourceSubnetExtraDhcpOptsV1(d),
+		},
+		IPv6Enable: d.Get("ipv6_enable").(bool),
 	}
 
 	n, err := subnets.Create(subnetClient, createOpts).Extract()
@@ -196,7 +288,8 @@ func resourceVpcSubnetV1Read(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
 	}
 
-	n, err := subnets.Get(subnetClient, d.Id()).Extract()
+	result := subnets.Get(subnetClient, d.Id())
+	n, err := result.Extract()
 	if err != nil {
 		if _, ok := err.(golangsdk.ErrDefault404); ok {
 			d.SetId("")
@@ -205,6 +298,10 @@ func resourceVpcSubnetV1Read(d *schema.ResourceData, meta interface{}) error {
 
 		return fmt.Errorf("Error retrieving OpenTelekomCloud Subnets: %s", err)
 	}
+	ipv6, err := ExtractVpcSubnetIPv6(result)
+	if err != nil {
+		return fmt.Errorf("error extracting subnet IPv6 attributes: %s", err)
+	}
 
 	d.Set("name", n.Name)
 	d.Set("cidr", n.CIDR)
@@ -217,12 +314,12 @@ func resourceVpcSubnetV1Read(d *schema.ResourceData, meta interface{}) error {
 	d.Set("vpc_id", n.VPC_ID)
 	d.Set("subnet_id", n.SubnetId)
 	d.Set("region", config.GetRegion(d))
+	if err := setVpcSubnetIPv6(d, ipv6); err != nil {
+		return fmt.Errorf("error setting subnet IPv6 attributes: %s", err)
+	}
 
-	for _, opt := range n.ExtraDhcpOpts {
-		if opt.OptName == "ntp" {
-			d.Set("ntp_addresses", opt.OptValue)
-			break
-		}
+	if err := setVpcSubnetExtraDhcpOpts(d, n.ExtraDhcpOpts); err != nil {
+		return fmt.Errorf("error setting subnet extra DHCP options: %s", err)
 	}
 
 	// save VpcSubnet tags
@@ -251,7 +348,7 @@ func resourceVpcSubnetV1Update(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
 	}
 
-	var updateOpts subnets.UpdateOpts
+	var updateOpts VpcSubnetUpdateOpts
 
 	// as name is mandatory while updating subnet
 	updateOpts.Name = d.Get("name").(string)
@@ -271,14 +368,12 @@ func resourceVpcSubnetV1Update(d *schema.ResourceData, meta interface{}) error {
 	} else if d.Get("dhcp_enable").(bool) { // maintaining dhcp to be true if it was true earlier as default update option for dhcp bool is always going to be false in golangsdk
 		updateOpts.EnableDHCP = true
 	}
-	if d.HasChange("ntp_addresses") {
-		var extraDhcpRequests []subnets.ExtraDhcpOpt
-		extraDhcpReq := subnets.ExtraDhcpOpt{
-			OptName:  "ntp",
-			OptValue: d.Get("ntp_addresses").(string),
-		}
-		extraDhcpRequests = append(extraDhcpRequests, extraDhcpReq)
-		updateOpts.ExtraDhcpOpts = extraDhcpRequests
+	if subnetExtraDhcpOptsChanged(d) {
+		updateOpts.ExtraDhcpOpts = resourceSubnetExtraDhcpOptsV1(d)
+	}
+	// IPv6 can't be disabled in the existing subnet, it's handled by CustomizeDiff
+	if d.HasChange("ipv6_enable") {
+		updateOpts.IPv6Enable = d.Get("ipv6_enable").(bool)
 	}
 
 	vpc_id := d.Get("vpc_id").(string)
@@ -331,6 +426,11 @@ func resourceVpcSubnetV1Delete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// isIPv6Disabled checks if IPv6 is disabled for the subnet, it requires the subnet recreation
+func isIPv6Disabled(old, new, _ interface{}) bool {
+	return old.(bool) && !new.(bool)
+}
+
 func waitForVpcSubnetActive(subnetClient *golangsdk.ServiceClient, vpcId string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		n, err := subnets.Get(subnetClient, vpcId).Extract()
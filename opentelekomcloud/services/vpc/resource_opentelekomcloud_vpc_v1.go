@@ -13,6 +13,7 @@ import (
 
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/eps"
 )
 
 func ResourceVirtualPrivateCloudV1() *schema.Resource {
@@ -22,7 +23,7 @@ func ResourceVirtualPrivateCloudV1() *schema.Resource {
 		Update: resourceVirtualPrivateCloudV1Update,
 		Delete: resourceVirtualPrivateCloudV1Delete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceVirtualPrivateCloudV1Import,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -49,6 +50,14 @@ func ResourceVirtualPrivateCloudV1() *schema.Resource {
 				ForceNew:     false,
 				ValidateFunc: common.ValidateCIDR,
 			},
+			"secondary_cidrs": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: common.ValidateCIDR,
+				},
+			},
 			"shared": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -60,6 +69,11 @@ func ResourceVirtualPrivateCloudV1() *schema.Resource {
 				Computed: true,
 			},
 			"tags": common.TagsSchema(),
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -98,6 +112,39 @@ func readNetworkingTags(d *schema.ResourceData, config *cfg.Config, resource str
 	return nil
 }
 
+// updateVpcSecondaryCIDRsV3 removes the secondary CIDRs missing in the configuration and adds the new ones,
+// the actual CIDRs of the VPC are compared, so the CIDRs added outside of Terraform aren't added again
+func updateVpcSecondaryCIDRsV3(d *schema.ResourceData, config *cfg.Config) error {
+	client, err := config.NetworkingV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud VPC v3 client: %s", err)
+	}
+
+	vpcV3, err := getVpcV3(client, d.Id())
+	if err != nil {
+		return fmt.Errorf("error retrieving secondary CIDRs of VPC: %s", err)
+	}
+	oldSet := schema.NewSet(schema.HashString, nil)
+	for _, cidr := range vpcV3.SecondaryCIDRs {
+		oldSet.Add(cidr)
+	}
+	newSet := d.Get("secondary_cidrs").(*schema.Set)
+
+	if removed := common.ExpandToStringSlice(oldSet.Difference(newSet).List()); len(removed) > 0 {
+		log.Printf("[DEBUG] Removing secondary CIDRs %v from VPC %s", removed, d.Id())
+		if err := removeVpcSecondaryCIDRs(client, d.Id(), removed); err != nil {
+			return fmt.Errorf("error removing secondary CIDRs of VPC: %s", err)
+		}
+	}
+	if added := common.ExpandToStringSlice(newSet.Difference(oldSet).List()); len(added) > 0 {
+		log.Printf("[DEBUG] Adding secondary CIDRs %v to VPC %s", added, d.Id())
+		if err := addVpcSecondaryCIDRs(client, d.Id(), added); err != nil {
+			return fmt.Errorf("error adding secondary CIDRs to VPC: %s", err)
+		}
+	}
+	return nil
+}
+
 func resourceVirtualPrivateCloudV1Create(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*cfg.Config)
 	vpcClient, err := config.NetworkingV1Client(config.GetRegion(d))
@@ -106,9 +153,12 @@ func resourceVirtualPrivateCloudV1Create(d *schema.ResourceData, meta interface{
 		return fmt.Errorf("Error creating OpenTelekomCloud vpc client: %s", err)
 	}
 
-	createOpts := vpcs.CreateOpts{
-		Name: d.Get("name").(string),
-		CIDR: d.Get("cidr").(string),
+	createOpts := VpcCreateOpts{
+		CreateOpts: vpcs.CreateOpts{
+			Name: d.Get("name").(string),
+			CIDR: d.Get("cidr").(string),
+		},
+		EnterpriseProjectID: d.Get("enterprise_project_id").(string),
 	}
 
 	n, err := vpcs.Create(vpcClient, createOpts).Extract()
@@ -151,6 +201,12 @@ func resourceVirtualPrivateCloudV1Create(d *schema.ResourceData, meta interface{
 		return err
 	}
 
+	if d.Get("secondary_cidrs").(*schema.Set).Len() > 0 {
+		if err := updateVpcSecondaryCIDRsV3(d, config); err != nil {
+			return err
+		}
+	}
+
 	return resourceVirtualPrivateCloudV1Read(d, meta)
 
 }
@@ -162,7 +218,8 @@ func resourceVirtualPrivateCloudV1Read(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error creating OpenTelekomCloud Vpc client: %s", err)
 	}
 
-	n, err := vpcs.Get(vpcClient, d.Id()).Extract()
+	result := vpcs.Get(vpcClient, d.Id())
+	n, err := result.Extract()
 	if err != nil {
 		if _, ok := err.(golangsdk.ErrDefault404); ok {
 			d.SetId("")
@@ -171,6 +228,10 @@ func resourceVirtualPrivateCloudV1Read(d *schema.ResourceData, meta interface{})
 
 		return fmt.Errorf("Error retrieving OpenTelekomCloud Vpc: %s", err)
 	}
+	epsID, err := eps.ExtractEnterpriseProjectID(result, "vpc")
+	if err != nil {
+		return fmt.Errorf("error extracting enterprise project ID of VPC: %s", err)
+	}
 
 	d.Set("id", n.ID)
 	d.Set("name", n.Name)
@@ -178,14 +239,52 @@ func resourceVirtualPrivateCloudV1Read(d *schema.ResourceData, meta interface{})
 	d.Set("status", n.Status)
 	d.Set("shared", n.EnableSharedSnat)
 	d.Set("region", config.GetRegion(d))
+	d.Set("enterprise_project_id", epsID)
 
 	if err := readNetworkingTags(d, config, "vpcs"); err != nil {
 		return err
 	}
 
+	// VPC v3 API is not available in all regions, it's queried only when secondary CIDRs are managed
+	if d.Get("secondary_cidrs").(*schema.Set).Len() > 0 {
+		if err := readVpcSecondaryCIDRs(d, config); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// readVpcSecondaryCIDRs sets secondary CIDRs of the VPC, the refresh isn't broken
+// if VPC v3 API isn't available in the region
+func readVpcSecondaryCIDRs(d *schema.ResourceData, config *cfg.Config) error {
+	vpcV3Client, err := config.NetworkingV3Client(config.GetRegion(d))
+	if err != nil {
+		log.Printf("[WARN] Unable to create OpenTelekomCloud VPC v3 client: %s", err)
+		return nil
+	}
+	vpcV3, err := getVpcV3(vpcV3Client, d.Id())
+	if err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); ok {
+			log.Printf("[WARN] Secondary CIDRs of VPC %s are not available: %s", d.Id(), err)
+			return nil
+		}
+		return fmt.Errorf("error retrieving secondary CIDRs of VPC: %s", err)
+	}
+	if err := d.Set("secondary_cidrs", vpcV3.SecondaryCIDRs); err != nil {
+		return fmt.Errorf("error setting secondary CIDRs of VPC: %s", err)
+	}
+	return nil
+}
+
+func resourceVirtualPrivateCloudV1Import(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// secondary CIDRs are read on refresh only when they're in the state
+	if err := readVpcSecondaryCIDRs(d, meta.(*cfg.Config)); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceVirtualPrivateCloudV1Update(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*cfg.Config)
 	vpcClient, err := config.NetworkingV1Client(config.GetRegion(d))
@@ -193,22 +292,24 @@ func resourceVirtualPrivateCloudV1Update(d *schema.ResourceData, meta interface{
 		return fmt.Errorf("Error creating OpenTelekomCloud Vpc: %s", err)
 	}
 
-	var updateOpts vpcs.UpdateOpts
+	if d.HasChanges("name", "cidr", "shared") {
+		var updateOpts vpcs.UpdateOpts
 
-	if d.HasChange("name") {
-		updateOpts.Name = d.Get("name").(string)
-	}
-	if d.HasChange("cidr") {
-		updateOpts.CIDR = d.Get("cidr").(string)
-	}
-	if d.HasChange("shared") {
-		snat := d.Get("shared").(bool)
-		updateOpts.EnableSharedSnat = &snat
-	}
+		if d.HasChange("name") {
+			updateOpts.Name = d.Get("name").(string)
+		}
+		if d.HasChange("cidr") {
+			updateOpts.CIDR = d.Get("cidr").(string)
+		}
+		if d.HasChange("shared") {
+			snat := d.Get("shared").(bool)
+			updateOpts.EnableSharedSnat = &snat
+		}
 
-	_, err = vpcs.Update(vpcClient, d.Id(), updateOpts).Extract()
-	if err != nil {
-		return fmt.Errorf("Error updating OpenTelekomCloud Vpc: %s", err)
+		_, err = vpcs.Update(vpcClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating OpenTelekomCloud Vpc: %s", err)
+		}
 	}
 
 	// update tags
@@ -224,6 +325,20 @@ func resourceVirtualPrivateCloudV1Update(d *schema.ResourceData, meta interface{
 		}
 	}
 
+	if d.HasChange("secondary_cidrs") {
+		if err := updateVpcSecondaryCIDRsV3(d, config); err != nil {
+			return err
+		}
+	}
+
+	migrateOpts := eps.MigrateResourceOpts{
+		ResourceType: "vpcs",
+		ResourceID:   d.Id(),
+	}
+	if err := eps.MigrateResource(d, config, migrateOpts); err != nil {
+		return err
+	}
+
 	return resourceVirtualPrivateCloudV1Read(d, meta)
 }
 
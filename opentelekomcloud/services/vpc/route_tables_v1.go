@@ -0,0 +1,116 @@
+package vpc
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// RouteTable represents the custom or default route table of the VPC.
+type RouteTable struct {
+	ID          string             `json:"id"`
+	Name        string             `json:"name"`
+	Description string             `json:"description"`
+	VpcID       string             `json:"vpc_id"`
+	Default     bool               `json:"default"`
+	Routes      []RouteTableRoute  `json:"routes"`
+	Subnets     []RouteTableSubnet `json:"subnets"`
+}
+
+// RouteTableRoute represents the route of the route table.
+type RouteTableRoute struct {
+	Type        string `json:"type"`
+	Destination string `json:"destination"`
+	NextHop     string `json:"nexthop"`
+	Description string `json:"description,omitempty"`
+}
+
+// RouteTableSubnet represents the subnet associated with the route table.
+type RouteTableSubnet struct {
+	ID string `json:"id"`
+}
+
+// RouteTableCreateOpts contains the values used for the route table creation.
+type RouteTableCreateOpts struct {
+	VpcID       string            `json:"vpc_id" required:"true"`
+	Name        string            `json:"name" required:"true"`
+	Description string            `json:"description,omitempty"`
+	Routes      []RouteTableRoute `json:"routes,omitempty"`
+}
+
+// RouteTableUpdateOpts contains the values used for the route table update.
+type RouteTableUpdateOpts struct {
+	Name        string  `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	// Routes contains the routes to be added, modified and deleted under `add`, `mod` and `del` keys
+	Routes map[string][]RouteTableRoute `json:"routes,omitempty"`
+}
+
+func routeTableURL(client *golangsdk.ServiceClient, parts ...string) string {
+	return client.ServiceURL(append([]string{client.ProjectID, "routetables"}, parts...)...)
+}
+
+func createRouteTable(client *golangsdk.ServiceClient, opts RouteTableCreateOpts) (*RouteTable, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "routetable")
+	if err != nil {
+		return nil, err
+	}
+	var res struct {
+		RouteTable RouteTable `json:"routetable"`
+	}
+	if _, err := client.Post(routeTableURL(client), b, &res, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	}); err != nil {
+		return nil, err
+	}
+	return &res.RouteTable, nil
+}
+
+func getRouteTable(client *golangsdk.ServiceClient, id string) (*RouteTable, error) {
+	var res struct {
+		RouteTable RouteTable `json:"routetable"`
+	}
+	if _, err := client.Get(routeTableURL(client, id), &res, nil); err != nil {
+		return nil, err
+	}
+	return &res.RouteTable, nil
+}
+
+func updateRouteTable(client *golangsdk.ServiceClient, id string, opts RouteTableUpdateOpts) error {
+	b, err := golangsdk.BuildRequestBody(opts, "routetable")
+	if err != nil {
+		return err
+	}
+	_, err = client.Put(routeTableURL(client, id), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+// updateRouteTableSubnets associates the subnets with the route table and disassociates them from it
+func updateRouteTableSubnets(client *golangsdk.ServiceClient, id string, associate, disassociate []string) error {
+	subnets := make(map[string][]string)
+	if len(associate) > 0 {
+		subnets["associate"] = associate
+	}
+	if len(disassociate) > 0 {
+		subnets["disassociate"] = disassociate
+	}
+	if len(subnets) == 0 {
+		return nil
+	}
+	b := map[string]interface{}{
+		"routetable": map[string]interface{}{
+			"subnets": subnets,
+		},
+	}
+	_, err := client.Post(routeTableURL(client, id, "action"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func deleteRouteTable(client *golangsdk.ServiceClient, id string) error {
+	_, err := client.Delete(routeTableURL(client, id), &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return err
+}
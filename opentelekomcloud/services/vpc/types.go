@@ -1,9 +1,13 @@
 package vpc
 
 import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/eips"
+	vpcsubnets "github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/subnets"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/vpcs"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/layer3/floatingips"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/layer3/routers"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/security/rules"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/networks"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/ports"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/subnets"
@@ -23,6 +27,59 @@ func (opts FloatingIPCreateOpts) ToFloatingIPCreateMap() (map[string]interface{}
 	return common.BuildRequest(opts, "floatingip")
 }
 
+// VpcCreateOpts represents the attributes used when creating a new VPC.
+type VpcCreateOpts struct {
+	vpcs.CreateOpts
+	EnterpriseProjectID string `json:"enterprise_project_id,omitempty"`
+}
+
+// ToVpcCreateMap casts a CreateOpts struct to a map.
+// It overrides vpcs.ToVpcCreateMap to add the EnterpriseProjectID field.
+func (opts VpcCreateOpts) ToVpcCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "vpc")
+}
+
+// VpcSubnetCreateOpts represents the attributes used when creating a new VPC subnet.
+type VpcSubnetCreateOpts struct {
+	vpcsubnets.CreateOpts
+	IPv6Enable bool `json:"ipv6_enable,omitempty"`
+}
+
+// ToSubnetCreateMap casts a CreateOpts struct to a map.
+// It overrides subnets.ToSubnetCreateMap to add the IPv6Enable field.
+func (opts VpcSubnetCreateOpts) ToSubnetCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "subnet")
+}
+
+// VpcSubnetUpdateOpts represents the attributes used when updating an existing VPC subnet.
+type VpcSubnetUpdateOpts struct {
+	vpcsubnets.UpdateOpts
+	IPv6Enable bool `json:"ipv6_enable,omitempty"`
+}
+
+// ToSubnetUpdateMap casts an UpdateOpts struct to a map.
+// It overrides subnets.ToSubnetUpdateMap to add the IPv6Enable field.
+func (opts VpcSubnetUpdateOpts) ToSubnetUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "subnet")
+}
+
+// VpcSubnetIPv6 contains the IPv6 attributes of the VPC subnet missing in subnets.Subnet.
+type VpcSubnetIPv6 struct {
+	IPv6Enable   bool   `json:"ipv6_enable"`
+	IPv6SubnetID string `json:"ipv6_subnet_id"`
+	IPv6CIDR     string `json:"cidr_v6"`
+	IPv6Gateway  string `json:"gateway_ip_v6"`
+}
+
+// ExtractVpcSubnetIPv6 extracts IPv6 attributes from the VPC subnet get result.
+func ExtractVpcSubnetIPv6(r vpcsubnets.GetResult) (*VpcSubnetIPv6, error) {
+	var ipv6 VpcSubnetIPv6
+	if err := r.ExtractIntoStructPtr(&ipv6, "subnet"); err != nil {
+		return nil, err
+	}
+	return &ipv6, nil
+}
+
 // NetworkCreateOpts represents the attributes used when creating a new network.
 type NetworkCreateOpts struct {
 	networks.CreateOpts
@@ -83,5 +140,59 @@ func (opts SubnetCreateOpts) ToSubnetCreateMap() (map[string]interface{}, error)
 // EIPCreateOpts represents the attributes used when creating a new eip.
 type EIPCreateOpts struct {
 	eips.ApplyOpts
-	ValueSpecs map[string]string `json:"value_specs,omitempty"`
+	ValueSpecs          map[string]string `json:"value_specs,omitempty"`
+	EnterpriseProjectID string            `json:"-"`
+}
+
+// ToPublicIpApplyMap casts an ApplyOpts struct to a map.
+// It overrides eips.ToPublicIpApplyMap to add the EnterpriseProjectID field.
+func (opts EIPCreateOpts) ToPublicIpApplyMap() (map[string]interface{}, error) {
+	b, err := opts.ApplyOpts.ToPublicIpApplyMap()
+	if err != nil {
+		return nil, err
+	}
+	if opts.EnterpriseProjectID != "" {
+		b["enterprise_project_id"] = opts.EnterpriseProjectID
+	}
+	return b, nil
+}
+
+// SecGroupRuleCreateOpts represents the attributes used when creating a new security group rule.
+type SecGroupRuleCreateOpts struct {
+	rules.CreateOpts
+	RemoteAddressGroupID string `json:"remote_address_group_id,omitempty"`
+}
+
+// ToSecGroupRuleCreateMap casts a CreateOpts struct to a map.
+// It overrides rules.ToSecGroupRuleCreateMap to add the RemoteAddressGroupID field.
+func (opts SecGroupRuleCreateOpts) ToSecGroupRuleCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "security_group_rule")
+}
+
+// SecGroupRule represents the security group rule with the attributes missing in rules.SecGroupRule.
+type SecGroupRule struct {
+	rules.SecGroupRule
+	RemoteAddressGroupID string `json:"remote_address_group_id"`
+}
+
+// ExtractSecGroupRule extracts the security group rule from the get or create result.
+func ExtractSecGroupRule(r golangsdk.Result) (*SecGroupRule, error) {
+	var rule SecGroupRule
+	if err := r.ExtractIntoStructPtr(&rule, "security_group_rule"); err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
+
+// ListSecGroupRules returns all rules of the security group.
+func ListSecGroupRules(client *golangsdk.ServiceClient, secGroupID string) ([]SecGroupRule, error) {
+	pages, err := rules.List(client, rules.ListOpts{SecGroupID: secGroupID}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	var secGroupRules []SecGroupRule
+	if err := pages.(rules.SecGroupRulePage).ExtractIntoSlicePtr(&secGroupRules, "security_group_rules"); err != nil {
+		return nil, err
+	}
+	return secGroupRules, nil
 }
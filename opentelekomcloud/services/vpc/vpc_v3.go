@@ -0,0 +1,47 @@
+package vpc
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// VpcV3 represents the VPC attributes available only in VPC v3 API.
+type VpcV3 struct {
+	ID             string   `json:"id"`
+	SecondaryCIDRs []string `json:"extend_cidrs"`
+}
+
+func vpcV3URL(client *golangsdk.ServiceClient, parts ...string) string {
+	return client.ServiceURL(append([]string{client.ProjectID, "vpc", "vpcs"}, parts...)...)
+}
+
+func getVpcV3(client *golangsdk.ServiceClient, id string) (*VpcV3, error) {
+	var res struct {
+		Vpc VpcV3 `json:"vpc"`
+	}
+	if _, err := client.Get(vpcV3URL(client, id), &res, nil); err != nil {
+		return nil, err
+	}
+	return &res.Vpc, nil
+}
+
+// updateVpcSecondaryCIDRs adds or removes the secondary CIDRs of the VPC depending on the `action`:
+// `add-extend-cidr` or `remove-extend-cidr`
+func updateVpcSecondaryCIDRs(client *golangsdk.ServiceClient, id, action string, cidrs []string) error {
+	b := map[string]interface{}{
+		"vpc": map[string]interface{}{
+			"extend_cidrs": cidrs,
+		},
+	}
+	_, err := client.Put(vpcV3URL(client, id, action), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func addVpcSecondaryCIDRs(client *golangsdk.ServiceClient, id string, cidrs []string) error {
+	return updateVpcSecondaryCIDRs(client, id, "add-extend-cidr", cidrs)
+}
+
+func removeVpcSecondaryCIDRs(client *golangsdk.ServiceClient, id string, cidrs []string) error {
+	return updateVpcSecondaryCIDRs(client, id, "remove-extend-cidr", cidrs)
+}